@@ -0,0 +1,289 @@
+package admin
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"io"
+	"postgres-explain/backend/modules"
+	"postgres-explain/backend/organization"
+	"time"
+)
+
+// dumpBatchSize is the number of lines inserted in the same transaction,
+// an interrupted import can be resumed from the last committed batch.
+// A batch holds the rows of one table, so that it is committed or rolled back as a whole.
+const dumpBatchSize = 10000
+
+// maxDumpLineSize is big enough for the largest plans
+const maxDumpLineSize = 64 * 1024 * 1024
+
+type DumpArgs struct {
+	Organization    string
+	ClusterName     string
+	PeriodStartFrom time.Time
+	PeriodStartTo   time.Time
+	// Tables to export, every table if empty
+	Tables []string
+}
+
+// DumpLine is one line of the NDJSON dump
+type DumpLine struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+type Dumper struct {
+	DB     *sqlx.DB
+	Tables []modules.Table
+	Log    *logrus.Entry
+}
+
+// Export writes the rows of the tables as NDJSON, it returns the number of written lines.
+func (d Dumper) Export(ctx context.Context, args DumpArgs, w io.Writer) (uint64, error) {
+	tables, err := d.selectTables(args.Tables)
+	if err != nil {
+		return 0, err
+	}
+
+	queryArgs := map[string]interface{}{
+		"organization":      args.Organization,
+		"cluster_name":      args.ClusterName,
+		"period_start_from": args.PeriodStartFrom,
+		"period_start_to":   args.PeriodStartTo,
+	}
+
+	encoder := json.NewEncoder(w)
+	var lines uint64
+	for _, table := range tables {
+		tableLines, err := d.exportTable(ctx, table, queryArgs, encoder)
+		lines += tableLines
+		if err != nil {
+			return lines, fmt.Errorf("could not export table %v: %v", table.Name, err)
+		}
+
+		d.Log.Infof("exported %d rows of table %v", tableLines, table.Name)
+	}
+
+	return lines, nil
+}
+
+func (d Dumper) exportTable(ctx context.Context, table modules.Table, queryArgs map[string]interface{}, encoder *json.Encoder) (uint64, error) {
+	query, args, err := sqlx.Named(table.SelectSQL, queryArgs)
+	if err != nil {
+		return 0, fmt.Errorf("could not prepare query: %v", err)
+	}
+
+	rows, err := d.DB.QueryxContext(ctx, d.DB.Rebind(query), args...)
+	if err != nil {
+		return 0, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+	defer rows.Close()
+
+	var lines uint64
+	for rows.Next() {
+		row := table.NewRow()
+		if err := rows.StructScan(row); err != nil {
+			return lines, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		rowJSON, err := json.Marshal(row)
+		if err != nil {
+			return lines, fmt.Errorf("could not marshal row: %v", err)
+		}
+
+		if err := encoder.Encode(DumpLine{Table: table.Name, Row: rowJSON}); err != nil {
+			return lines, fmt.Errorf("could not write line: %v", err)
+		}
+		lines++
+	}
+
+	return lines, rows.Err()
+}
+
+// Import inserts the rows of a NDJSON dump (optionally gzipped) with the insert statement of their table.
+// If organization is not empty every row is assigned to it, otherwise rows keep the organization of the dump
+// (Default for dumps created before organizations were introduced).
+// The first skipLines lines are skipped to resume an interrupted import, committed is called after every
+// committed batch with the number of lines imported so far (skipped lines included).
+// The batches hold the consecutive rows of one table, the dumps are written table by table.
+// It returns the number of imported lines, skipped lines excluded.
+func (d Dumper) Import(ctx context.Context, r io.Reader, organizationName string, skipLines uint64, committed func(lines uint64) error) (uint64, error) {
+	reader, err := NewDumpReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	tables := make(map[string]modules.Table)
+	for _, table := range d.Tables {
+		tables[table.Name] = table
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxDumpLineSize)
+
+	var lines, imported uint64
+	var batchTable string
+	batch := make([]interface{}, 0, dumpBatchSize)
+	// flush inserts the batch, whose last row is on line batchEnd
+	flush := func(batchEnd uint64) error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := d.insertBatch(ctx, tables[batchTable], batch); err != nil {
+			return fmt.Errorf("could not insert batch into %v: %v", batchTable, err)
+		}
+		imported += uint64(len(batch))
+		batch = make([]interface{}, 0, dumpBatchSize)
+
+		if committed != nil {
+			return committed(batchEnd)
+		}
+		return nil
+	}
+
+	for scanner.Scan() {
+		lines++
+		if lines <= skipLines {
+			continue
+		}
+
+		line := DumpLine{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return imported, fmt.Errorf("could not unmarshal line %d: %v", lines, err)
+		}
+
+		table, ok := tables[line.Table]
+		if !ok {
+			return imported, fmt.Errorf("table %v of line %d cannot be imported", line.Table, lines)
+		}
+
+		row := table.NewRow()
+		if err := json.Unmarshal(line.Row, row); err != nil {
+			return imported, fmt.Errorf("could not unmarshal row of line %d: %v", lines, err)
+		}
+		assignOrganization(row, organizationName)
+
+		if line.Table != batchTable {
+			if err := flush(lines - 1); err != nil {
+				return imported, err
+			}
+			batchTable = line.Table
+		}
+
+		batch = append(batch, row)
+		if len(batch) < dumpBatchSize {
+			continue
+		}
+
+		if err := flush(lines); err != nil {
+			return imported, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return imported, fmt.Errorf("could not read line %d: %v", lines+1, err)
+	}
+
+	return imported, flush(lines)
+}
+
+// assignOrganization sets the organization of the row, rows without one belong to Default
+func assignOrganization(row interface{}, organizationName string) {
+	organizationRow, ok := row.(modules.OrganizationRow)
+	if !ok {
+		return
+	}
+
+	switch {
+	case organizationName != "":
+		organizationRow.SetOrganization(organizationName)
+	case organizationRow.GetOrganization() == "":
+		organizationRow.SetOrganization(organization.Default)
+	}
+}
+
+func (d Dumper) insertBatch(ctx context.Context, table modules.Table, rows []interface{}) (err error) {
+	// begin "transaction" and commit or rollback it on exit
+	var tx *sqlx.Tx
+	if tx, err = d.DB.BeginTxx(ctx, nil); err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(err, "failed to commit transaction")
+		}
+	}()
+
+	// prepare INSERT statement and close it on exit
+	var stmt *sqlx.NamedStmt
+	if stmt, err = tx.PrepareNamedContext(ctx, table.InsertSQL); err != nil {
+		return errors.Wrap(err, "failed to prepare statement")
+	}
+	defer func() {
+		if e := stmt.Close(); e != nil && err == nil {
+			err = errors.Wrap(e, "failed to close statement")
+		}
+	}()
+
+	for _, row := range rows {
+		if _, err = stmt.ExecContext(ctx, row); err != nil {
+			return errors.Wrap(err, "failed to exec")
+		}
+	}
+
+	return nil
+}
+
+func (d Dumper) selectTables(names []string) ([]modules.Table, error) {
+	if len(names) == 0 {
+		return d.Tables, nil
+	}
+
+	tables := make([]modules.Table, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, table := range d.Tables {
+			if table.Name == name {
+				tables = append(tables, table)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("table %v cannot be exported", name)
+		}
+	}
+
+	return tables, nil
+}
+
+// NewDumpReader returns a reader of the dump, decompressing it if it is gzipped.
+func NewDumpReader(r io.Reader) (io.Reader, error) {
+	reader := bufio.NewReader(r)
+	magic, err := reader.Peek(2)
+	if err == io.EOF {
+		return reader, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read dump: %v", err)
+	}
+
+	if magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("could not gunzip dump: %v", err)
+		}
+		return gzipReader, nil
+	}
+
+	return reader, nil
+}
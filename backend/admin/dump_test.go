@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"postgres-explain/backend/modules"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite" // register sqlite database/sql driver
+)
+
+func TestNewDumpReader(t *testing.T) {
+	dump := `{"table":"plans","row":{"id":"1"}}` + "\n"
+
+	var gzipped bytes.Buffer
+	gzipWriter := gzip.NewWriter(&gzipped)
+	_, err := gzipWriter.Write([]byte(dump))
+	assert.NoError(t, err)
+	assert.NoError(t, gzipWriter.Close())
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{name: "plain", input: []byte(dump), want: dump},
+		{name: "gzipped", input: gzipped.Bytes(), want: dump},
+		{name: "empty", input: []byte{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := NewDumpReader(bytes.NewReader(tt.input))
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestDumper_selectTables(t *testing.T) {
+	d := Dumper{Tables: []modules.Table{{Name: "activities"}, {Name: "analytics"}, {Name: "plans"}}}
+
+	tables, err := d.selectTables(nil)
+	assert.NoError(t, err)
+	assert.Len(t, tables, 3)
+
+	tables, err = d.selectTables([]string{"plans"})
+	assert.NoError(t, err)
+	assert.Equal(t, []modules.Table{{Name: "plans"}}, tables)
+
+	_, err = d.selectTables([]string{"random"})
+	assert.Error(t, err)
+}
+
+type organizationRow struct {
+	Organization string
+}
+
+func (r *organizationRow) GetOrganization() string {
+	return r.Organization
+}
+
+func (r *organizationRow) SetOrganization(organization string) {
+	r.Organization = organization
+}
+
+func TestAssignOrganization(t *testing.T) {
+	tests := []struct {
+		name         string
+		row          *organizationRow
+		organization string
+		want         string
+	}{
+		{name: "forced", row: &organizationRow{Organization: "team-a"}, organization: "team-b", want: "team-b"},
+		{name: "kept", row: &organizationRow{Organization: "team-a"}, want: "team-a"},
+		{name: "dump without organizations", row: &organizationRow{}, want: "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignOrganization(tt.row, tt.organization)
+			assert.Equal(t, tt.want, tt.row.Organization)
+		})
+	}
+}
+
+type dumpTestRow struct {
+	ID int `json:"id"`
+}
+
+func TestDumper_Import(t *testing.T) {
+	db := sqlx.MustOpen("sqlite", ":memory:")
+	defer db.Close()
+	// every connection opens its own in-memory database
+	db.SetMaxOpenConns(1)
+	db.Mapper = reflectx.NewMapperFunc("json", strings.ToLower)
+	db.MustExec("CREATE TABLE plans (id INTEGER)")
+	newRow := func() interface{} { return &dumpTestRow{} }
+	dumper := Dumper{DB: db, Tables: []modules.Table{
+		{Name: "plans", InsertSQL: "INSERT INTO plans (id) VALUES (:id)", NewRow: newRow},
+		{Name: "activities", InsertSQL: "INSERT INTO activities (id) VALUES (:id)", NewRow: newRow},
+	}}
+	dump := `{"table":"plans","row":{"id":1}}
+{"table":"plans","row":{"id":2}}
+{"table":"activities","row":{"id":3}}
+`
+
+	// the batch of the table which fails is not committed, the plans are checkpointed on their own
+	checkpoints := make([]uint64, 0)
+	imported, err := dumper.Import(context.Background(), strings.NewReader(dump), "", 0, func(lines uint64) error {
+		checkpoints = append(checkpoints, lines)
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, uint64(2), imported)
+	assert.Equal(t, []uint64{2}, checkpoints)
+
+	// resuming from the checkpoint does not insert the plans again
+	db.MustExec("CREATE TABLE activities (id INTEGER)")
+	imported, err = dumper.Import(context.Background(), strings.NewReader(dump), "", 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), imported)
+
+	var plans int
+	assert.NoError(t, db.Get(&plans, "SELECT count(*) FROM plans"))
+	assert.Equal(t, 2, plans)
+}
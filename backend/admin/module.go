@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/borealisdb/commons/credentials"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/modules"
+	"postgres-explain/proto"
+)
+
+const ModuleName = "admin"
+
+type Module struct {
+	DB  *sqlx.DB
+	Log *logrus.Entry
+
+	modules.Params
+}
+
+func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider credentials.Credentials, params modules.Params) {
+	m.Log = log.WithField("module", ModuleName)
+	m.DB = db
+	m.Params = params
+	m.Log.Infof("registered")
+}
+
+func (m *Module) Init(initArgs modules.InitArgs) error {
+	service := Service{
+		log:           m.Log,
+		Repo:          Repository{DB: m.DB},
+		Dumper:        Dumper{DB: m.DB, Tables: m.Tables, Log: m.Log},
+		retentionDays: m.RetentionDays,
+	}
+
+	proto.RegisterAdminServer(initArgs.GrpcServer, &service)
+	if err := proto.RegisterAdminHandlerFromEndpoint(initArgs.Ctx, initArgs.Mux, initArgs.GrpcAddress, initArgs.Opts); err != nil {
+		return fmt.Errorf("could not register AdminHandlerFromEndpoint: %v", err)
+	}
+	m.Log.Infof("initialized")
+	return nil
+}
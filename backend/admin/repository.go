@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/shared"
+	"time"
+)
+
+type Repository struct {
+	DB *sqlx.DB
+}
+
+const getTablesStorageSQL = `
+SELECT table,
+       sum(bytes_on_disk)       AS bytes_on_disk,
+       sum(rows)                AS rows,
+       uniqExact(partition)     AS partitions,
+       min(partition)           AS oldest_partition,
+       min(min_time)            AS oldest_period_start
+FROM system.parts
+WHERE database = currentDatabase()
+  AND active
+  AND table IN (:tables)
+GROUP BY table
+ORDER BY table`
+
+func (r Repository) GetTablesStorage(ctx context.Context, tables []string) ([]TableStorageDB, error) {
+	query, args, err := sqlx.Named(getTablesStorageSQL, map[string]interface{}{"tables": tables})
+	if err != nil {
+		return nil, fmt.Errorf("could not bind getTablesStorageSQL: %v", err)
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not expand getTablesStorageSQL arguments: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := r.DB.QueryxContext(queryCtx, r.DB.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+	defer rows.Close()
+
+	tablesStorage := make([]TableStorageDB, 0)
+	for rows.Next() {
+		tableStorage := TableStorageDB{}
+		if err := rows.StructScan(&tableStorage); err != nil {
+			return nil, fmt.Errorf("could not StructScan TableStorageDB: %v", err)
+		}
+		tablesStorage = append(tablesStorage, tableStorage)
+	}
+
+	return tablesStorage, nil
+}
+
+const selectOldPartitionsSQL = `
+SELECT DISTINCT partition
+FROM system.parts
+WHERE database = currentDatabase()
+  AND table = ?
+  AND active
+  AND toUInt32(partition) < toYYYYMMDD(now() - toIntervalDay(?))
+ORDER BY partition`
+
+// DropOldPartitions drops the partitions of the table older than the given number of days.
+// Tables are partitioned by toYYYYMMDD(period_start), thus each partition is one day of data.
+func DropOldPartitions(db *sqlx.DB, table string, days uint, log *logrus.Entry) {
+	if days == 0 {
+		log.Debugf("Retention is disabled for table %v", table)
+		return
+	}
+
+	partitions := []string{}
+	if err := db.Select(&partitions, selectOldPartitionsSQL, table, days); err != nil {
+		log.Errorf("Select %d days old partitions of %v from system.parts. Result: %v, Error: %v", days, table, partitions, err)
+		return
+	}
+	for _, part := range partitions {
+		result, err := db.Exec(fmt.Sprintf(`ALTER TABLE %v DROP PARTITION %s`, table, part))
+		log.Infof("Drop %s partitions of %v. Result: %v, Error: %v", part, table, result, err)
+	}
+}
+
+// DeleteOldRows deletes the rows of the table older than the given number of days,
+// it is used when the storage is not partitioned (SQLite or PostgreSQL).
+func DeleteOldRows(db *sqlx.DB, table string, days uint, log *logrus.Entry) {
+	if days == 0 {
+		log.Debugf("Retention is disabled for table %v", table)
+		return
+	}
+
+	olderThan := time.Now().AddDate(0, 0, -int(days))
+	result, err := db.Exec(db.Rebind(fmt.Sprintf(`DELETE FROM %v WHERE period_start < ?`, table)), olderThan)
+	if err != nil {
+		log.Errorf("Delete %d days old rows of %v. Error: %v", days, table, err)
+		return
+	}
+
+	deleted, _ := result.RowsAffected()
+	log.Infof("Deleted %d rows older than %d days of %v", deleted, days, table)
+}
+
+type TableStorageDB struct {
+	Table             string    `json:"table"`
+	BytesOnDisk       uint64    `json:"bytes_on_disk"`
+	Rows              uint64    `json:"rows"`
+	Partitions        uint64    `json:"partitions"`
+	OldestPartition   string    `json:"oldest_partition"`
+	OldestPeriodStart time.Time `json:"oldest_period_start"`
+}
@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"postgres-explain/backend/modules"
+	"postgres-explain/backend/organization"
+	"postgres-explain/proto"
+	"sort"
+)
+
+type Service struct {
+	log           *logrus.Entry
+	Repo          Repository
+	Dumper        Dumper
+	retentionDays map[string]uint
+
+	proto.AdminServer
+}
+
+func (s *Service) GetStorageUsage(ctx context.Context, request *proto.GetStorageUsageRequest) (*proto.GetStorageUsageResponse, error) {
+	if s.Repo.DB.DriverName() != modules.ClickHouseDriver {
+		return nil, status.Errorf(codes.Unimplemented, "storage usage is only available with %v storage", modules.ClickHouseDriver)
+	}
+
+	tables := make([]string, 0, len(s.retentionDays))
+	for table := range s.retentionDays {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	tablesStorage, err := s.Repo.GetTablesStorage(ctx, tables)
+	if err != nil {
+		s.log.Errorf("could not GetTablesStorage: %v", err)
+		return nil, fmt.Errorf("could not get tables storage")
+	}
+
+	tablesStorageProto := make([]*proto.TableStorage, 0)
+	for _, tableStorage := range tablesStorage {
+		tablesStorageProto = append(tablesStorageProto, &proto.TableStorage{
+			Table:             tableStorage.Table,
+			BytesOnDisk:       tableStorage.BytesOnDisk,
+			Rows:              tableStorage.Rows,
+			Partitions:        tableStorage.Partitions,
+			OldestPartition:   tableStorage.OldestPartition,
+			OldestPeriodStart: timestamppb.New(tableStorage.OldestPeriodStart),
+			RetentionDays:     uint32(s.retentionDays[tableStorage.Table]),
+		})
+	}
+
+	return &proto.GetStorageUsageResponse{Tables: tablesStorageProto}, nil
+}
+
+// exportChunkSize is the size of the data sent in each ExportDataResponse
+const exportChunkSize = 1024 * 1024
+
+func (s *Service) ExportData(request *proto.ExportDataRequest, stream proto.Admin_ExportDataServer) error {
+	if request.PeriodStartFrom == nil || request.PeriodStartTo == nil {
+		return status.Errorf(codes.InvalidArgument, "period_start_from and period_start_to are required")
+	}
+	if request.ClusterName == "" {
+		return status.Errorf(codes.InvalidArgument, "cluster_name is required")
+	}
+
+	chunks := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	var w io.Writer = chunks
+	var gzipWriter *gzip.Writer
+	if request.Gzip {
+		gzipWriter = gzip.NewWriter(chunks)
+		w = gzipWriter
+	}
+
+	lines, err := s.Dumper.Export(stream.Context(), DumpArgs{
+		Organization:    organization.FromContext(stream.Context()),
+		ClusterName:     request.ClusterName,
+		PeriodStartFrom: request.PeriodStartFrom.AsTime(),
+		PeriodStartTo:   request.PeriodStartTo.AsTime(),
+		Tables:          request.Tables,
+	}, w)
+	if err != nil {
+		s.log.Errorf("could not Export after %d lines: %v", lines, err)
+		return fmt.Errorf("could not export data")
+	}
+
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			return fmt.Errorf("could not close gzip writer: %v", err)
+		}
+	}
+
+	return chunks.Flush()
+}
+
+type chunkWriter struct {
+	stream proto.Admin_ExportDataServer
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	// the stream keeps a reference to the message until it is sent, thus we copy the buffer
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := c.stream.Send(&proto.ExportDataResponse{Data: data}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (s *Service) ImportData(stream proto.Admin_ImportDataServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&proto.ImportDataResponse{})
+	}
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		request := first
+		for {
+			if _, err := writer.Write(request.Data); err != nil {
+				return
+			}
+
+			request, err = stream.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				return
+			}
+			if err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	var committedLines uint64
+	imported, err := s.Dumper.Import(stream.Context(), reader, organization.FromContext(stream.Context()), first.SkipLines, func(lines uint64) error {
+		committedLines = lines
+		return nil
+	})
+	// unblock the goroutine if the import stopped before reading the whole dump
+	_ = reader.Close()
+	if err != nil {
+		s.log.Errorf("could not Import: %v", err)
+		return status.Errorf(codes.Aborted, "import interrupted, resume it with skip_lines %d: %v", committedLines, err)
+	}
+
+	return stream.SendAndClose(&proto.ImportDataResponse{
+		ImportedLines: imported,
+		SkippedLines:  first.SkipLines,
+	})
+}
@@ -21,4 +21,8 @@ func (f Factory) Get(provider string) Auth {
 type Params struct {
 	IssuerUrl string
 	ClientID  string
+	// OrganizationClaim is the claim of the ID token containing the organization of the user
+	OrganizationClaim string
+	// MultiTenant rejects the users whose ID token has no organization claim instead of assigning them to the default organization
+	MultiTenant bool
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"postgres-explain/backend/organization"
 )
 
 const DisabledType = "disabled"
@@ -14,7 +15,7 @@ type Disabled struct {
 
 func (d Disabled) AuthMiddleware(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		h.ServeHTTP(w, r)
+		h.ServeHTTP(w, r.WithContext(organization.NewContext(r.Context(), organization.Default)))
 	})
 }
 
@@ -6,7 +6,10 @@ import (
 	"github.com/borealisdb/commons/auth"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
+	"postgres-explain/backend/organization"
 )
 
 const Oauth2Type = "oauth2"
@@ -19,6 +22,7 @@ type Oauth2 struct {
 
 func (d *Oauth2) Init(ctx context.Context, params Params) error {
 	d.Log.Infof("authentication type is %v", Oauth2Type)
+	d.Params = params
 	_, idTokenVerifier, err := auth.InitializeAuth(ctx, params.IssuerUrl, params.ClientID)
 	if err != nil {
 		return fmt.Errorf("could not InitializeAuth: %v", err)
@@ -29,18 +33,30 @@ func (d *Oauth2) Init(ctx context.Context, params Params) error {
 	return nil
 }
 
-func (d *Oauth2) verifyToken(ctx context.Context, rawIDToken string) (auth.IDTokenClaims, error) {
+func (d *Oauth2) verifyToken(ctx context.Context, rawIDToken string) (auth.IDTokenClaims, string, error) {
 	idToken, err := d.idTokenVerifier.Verify(ctx, rawIDToken)
 	if err != nil {
-		return auth.IDTokenClaims{}, err
+		return auth.IDTokenClaims{}, "", err
 	}
 
 	claims := auth.IDTokenClaims{}
 	if err := idToken.Claims(&claims); err != nil {
-		return auth.IDTokenClaims{}, err
+		return auth.IDTokenClaims{}, "", err
 	}
 
-	return claims, err
+	allClaims := make(map[string]interface{})
+	if err := idToken.Claims(&allClaims); err != nil {
+		return auth.IDTokenClaims{}, "", err
+	}
+	org, _ := allClaims[d.OrganizationClaim].(string)
+	if org == "" {
+		if d.MultiTenant {
+			return auth.IDTokenClaims{}, "", status.Errorf(codes.PermissionDenied, "ID token has no %v claim", d.OrganizationClaim)
+		}
+		org = organization.Default
+	}
+
+	return claims, org, err
 }
 
 func (d *Oauth2) AuthMiddleware(h http.Handler) http.Handler {
@@ -49,11 +65,16 @@ func (d *Oauth2) AuthMiddleware(h http.Handler) http.Handler {
 		if err != nil {
 			borealisCookie = &http.Cookie{} // TODO, maybe this is not the best
 		}
-		if _, err := d.verifyToken(r.Context(), borealisCookie.Value); err != nil {
+		_, org, err := d.verifyToken(r.Context(), borealisCookie.Value)
+		if status.Code(err) == codes.PermissionDenied {
+			w.WriteHeader(403)
+			return
+		}
+		if err != nil {
 			w.WriteHeader(401)
 			return
 		}
 
-		h.ServeHTTP(w, r)
+		h.ServeHTTP(w, r.WithContext(organization.NewContext(r.Context(), org)))
 	})
 }
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"postgres-explain/backend/organization"
+	"testing"
+)
+
+// unsignedToken returns an ID token with the given claims, its signature is not checked by the verifier of the tests
+func unsignedToken(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"RS256"}`)) + "." + encode([]byte(claims)) + "." + encode([]byte("signature"))
+}
+
+func TestOauth2_verifyToken(t *testing.T) {
+	verifier := oidc.NewVerifier("issuer", &oidc.StaticKeySet{}, &oidc.Config{
+		SkipClientIDCheck:          true,
+		SkipExpiryCheck:            true,
+		InsecureSkipSignatureCheck: true,
+	})
+
+	tests := []struct {
+		name        string
+		multiTenant bool
+		token       string
+		want        string
+		wantCode    codes.Code
+	}{
+		{
+			name:  "organization claim",
+			token: unsignedToken(`{"iss":"issuer","org":"team-a"}`),
+			want:  "team-a",
+		},
+		{
+			name:  "no organization claim",
+			token: unsignedToken(`{"iss":"issuer"}`),
+			want:  organization.Default,
+		},
+		{
+			name:        "multi tenant organization claim",
+			multiTenant: true,
+			token:       unsignedToken(`{"iss":"issuer","org":"team-a"}`),
+			want:        "team-a",
+		},
+		{
+			name:        "multi tenant no organization claim",
+			multiTenant: true,
+			token:       unsignedToken(`{"iss":"issuer"}`),
+			wantCode:    codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Oauth2{
+				idTokenVerifier: verifier,
+				Params:          Params{OrganizationClaim: "org", MultiTenant: tt.multiTenant},
+			}
+
+			_, got, err := d.verifyToken(context.Background(), tt.token)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -23,12 +23,13 @@ func New(params Params) (*Client, error) {
 	return &Client{client: cache.New[[]byte](gocachestore.NewGoCache(client))}, nil
 }
 
+// SetInstance stores the instance among the ones of its cluster, clusters are kept per organization
 func (c *Client) SetInstance(ctx context.Context, instance Instance) error {
-	if err := c.SetCluster(ctx, instance.ClusterName); err != nil {
+	if err := c.SetCluster(ctx, instance.Organization, instance.ClusterName); err != nil {
 		return fmt.Errorf("could not SetCluster: %v", err)
 	}
 
-	cluster, err := c.getClusterInstances(ctx, instance.ClusterName)
+	cluster, err := c.getClusterInstances(ctx, instance.Organization, instance.ClusterName)
 	if err != nil {
 		return fmt.Errorf("could not getClusterInstances: %v", err)
 	}
@@ -39,11 +40,11 @@ func (c *Client) SetInstance(ctx context.Context, instance Instance) error {
 	if err != nil {
 		return fmt.Errorf("could not Marshal: %v", err)
 	}
-	return c.client.Set(ctx, c.getInstanceKey(instance.ClusterName), marshal)
+	return c.client.Set(ctx, c.getInstanceKey(instance.Organization, instance.ClusterName), marshal)
 }
 
-func (c *Client) GetInstance(ctx context.Context, clusterName, name string) (Instance, error) {
-	cluster, err := c.getClusterInstances(ctx, clusterName)
+func (c *Client) GetInstance(ctx context.Context, organization, clusterName, name string) (Instance, error) {
+	cluster, err := c.getClusterInstances(ctx, organization, clusterName)
 	if err != nil {
 		return Instance{}, err
 	}
@@ -51,8 +52,8 @@ func (c *Client) GetInstance(ctx context.Context, clusterName, name string) (Ins
 	return cluster[name], nil
 }
 
-func (c *Client) SetCluster(ctx context.Context, clusterName string) error {
-	clusters, err := c.GetClusters(ctx)
+func (c *Client) SetCluster(ctx context.Context, organization, clusterName string) error {
+	clusters, err := c.GetClusters(ctx, organization)
 	if err != nil {
 		return fmt.Errorf("could not GetClusters: %v", err)
 	}
@@ -63,13 +64,13 @@ func (c *Client) SetCluster(ctx context.Context, clusterName string) error {
 		return fmt.Errorf("could not marshal clusters: %v", err)
 	}
 
-	return c.client.Set(ctx, "clusters", marshal)
+	return c.client.Set(ctx, c.getClustersKey(organization), marshal)
 }
 
-func (c *Client) GetClusters(ctx context.Context) (map[string]string, error) {
+func (c *Client) GetClusters(ctx context.Context, organization string) (map[string]string, error) {
 	clusters := make(map[string]string)
 
-	get, err := c.client.Get(ctx, "clusters")
+	get, err := c.client.Get(ctx, c.getClustersKey(organization))
 	if errors.Is(err, store.NotFound{}) {
 		return clusters, nil
 	}
@@ -84,13 +85,13 @@ func (c *Client) GetClusters(ctx context.Context) (map[string]string, error) {
 	return clusters, nil
 }
 
-func (c *Client) GetClusterInstances(ctx context.Context, clusterName string) (map[string]Instance, error) {
-	return c.getClusterInstances(ctx, clusterName)
+func (c *Client) GetClusterInstances(ctx context.Context, organization, clusterName string) (map[string]Instance, error) {
+	return c.getClusterInstances(ctx, organization, clusterName)
 }
 
-func (c *Client) getClusterInstances(ctx context.Context, clusterName string) (map[string]Instance, error) {
+func (c *Client) getClusterInstances(ctx context.Context, organization, clusterName string) (map[string]Instance, error) {
 	instancesMap := make(map[string]Instance)
-	key := c.getInstanceKey(clusterName)
+	key := c.getInstanceKey(organization, clusterName)
 	get, err := c.client.Get(ctx, key)
 	if errors.Is(err, store.NotFound{}) {
 		return instancesMap, nil
@@ -105,13 +106,34 @@ func (c *Client) getClusterInstances(ctx context.Context, clusterName string) (m
 	return instancesMap, nil
 }
 
-func (c *Client) getInstanceKey(clusterName string) string {
-	return fmt.Sprintf("cluster-%v", clusterName)
+func (c *Client) getClustersKey(organization string) string {
+	return fmt.Sprintf("clusters-%q", organization)
 }
 
+func (c *Client) getInstanceKey(organization, clusterName string) string {
+	return fmt.Sprintf("cluster-%q-%q", organization, clusterName)
+}
+
+const (
+	RolePrimary = "primary"
+	RoleStandby = "standby"
+)
+
 type Instance struct {
-	ClusterName   string `json:"cluster_name"`
-	Name          string `json:"instance_name"`
-	Host          string `json:"host"`
-	CollectorHost string `json:"collector_host"`
+	Organization       string  `json:"organization"`
+	ClusterName        string  `json:"cluster_name"`
+	Name               string  `json:"instance_name"`
+	Host               string  `json:"host"`
+	CollectorHost      string  `json:"collector_host"`
+	Role               string  `json:"role"`
+	ReplicationLagSecs float32 `json:"replication_lag_secs"`
+	ServerVersionNum   uint32  `json:"server_version_num"`
+}
+
+func (i Instance) IsPrimary() bool {
+	return i.Role == RolePrimary
+}
+
+func (i Instance) IsStandby() bool {
+	return i.Role == RoleStandby
 }
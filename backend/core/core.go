@@ -1,6 +1,7 @@
 package core
 
 import (
+	"postgres-explain/backend/admin"
 	"postgres-explain/backend/core/analytics"
 	"postgres-explain/backend/core/info"
 	"postgres-explain/backend/core/query_explainer"
@@ -10,5 +11,6 @@ import (
 var CoreModules = map[string]modules.Module{
 	query_explainer.ModuleName: &query_explainer.Module{},
 	analytics.ModuleName:       &analytics.Module{},
+	admin.ModuleName:           &admin.Module{},
 	info.ModuleName:            &info.Module{},
 }
@@ -25,7 +25,11 @@ func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider cr
 }
 
 func (m *Module) Init(initArgs modules.InitArgs) error {
-	repository := Repository{DB: m.DB, Log: m.Log}
+	repository, err := NewRepository(m.DB, m.Log)
+	if err != nil {
+		return fmt.Errorf("could not NewRepository: %v", err)
+	}
+
 	service := Service{
 		log:                 m.Log,
 		Repo:                repository,
@@ -39,3 +43,14 @@ func (m *Module) Init(initArgs modules.InitArgs) error {
 	m.Log.Infof("initialized")
 	return nil
 }
+
+func (m *Module) Tables() []modules.Table {
+	return []modules.Table{{
+		Name:      "plans",
+		SelectSQL: exportQueryPlans,
+		InsertSQL: insertQueryPlan,
+		NewRow: func() interface{} {
+			return &PlanEntity{}
+		},
+	}}
+}
@@ -8,7 +8,53 @@ import (
 	"postgres-explain/backend/shared"
 )
 
-type Repository struct {
+const (
+	clickHouseDriver = "clickhouse"
+	sqliteDriver     = "sqlite"
+	postgresDriver   = "postgres"
+)
+
+// Repository stores the saved plans, core mode can use ClickHouse, SQLite or PostgreSQL
+type Repository interface {
+	GetQueryPlan(ctx context.Context, organization, planID string) (PlanEntity, error)
+	SaveQueryPlan(ctx context.Context, entity PlanEntity) error
+	GetPlansList(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error)
+	GetOptimizations(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error)
+}
+
+// NewRepository returns the Repository implementation for the driver of the given db
+func NewRepository(db *sqlx.DB, log *logrus.Entry) (Repository, error) {
+	repo := sqlRepository{DB: db, Log: log}
+	switch db.DriverName() {
+	case clickHouseDriver:
+		return ClickHouseRepository{repo}, nil
+	case sqliteDriver:
+		return SQLiteRepository{repo}, nil
+	case postgresDriver:
+		return PostgresRepository{repo}, nil
+	default:
+		return nil, fmt.Errorf("storage driver %v is not supported", db.DriverName())
+	}
+}
+
+// ClickHouseRepository uses the plans table created by the ClickHouse migrations
+type ClickHouseRepository struct {
+	sqlRepository
+}
+
+// SQLiteRepository uses an embedded SQLite database, the schema is in migrations/sqlite
+type SQLiteRepository struct {
+	sqlRepository
+}
+
+// PostgresRepository uses a PostgreSQL database, the schema is in migrations/postgres
+type PostgresRepository struct {
+	sqlRepository
+}
+
+// sqlRepository contains the queries shared by all the implementations,
+// they only use standard SQL, placeholders are rebound for each driver.
+type sqlRepository struct {
 	DB  *sqlx.DB
 	Log *logrus.Entry
 }
@@ -27,11 +73,12 @@ SELECT 	id,
    period_start,
    optimization_id
 FROM plans
-WHERE id = :plan_id;`
+WHERE organization = :organization AND id = :plan_id;`
 
-func (ar Repository) GetQueryPlan(ctx context.Context, planID string) (PlanEntity, error) {
+func (ar sqlRepository) GetQueryPlan(ctx context.Context, organization, planID string) (PlanEntity, error) {
 	queryArgs := map[string]interface{}{
-		"plan_id": planID,
+		"organization": organization,
+		"plan_id":      planID,
 	}
 	rows, err := ar.DB.NamedQueryContext(ctx, selectQueryPlan, queryArgs)
 	if err != nil {
@@ -55,9 +102,32 @@ func (ar Repository) GetQueryPlan(ctx context.Context, planID string) (PlanEntit
 	return planEntities[0], nil
 }
 
+// exportQueryPlans selects the plans of a cluster with the columns named after the parameters of insertQueryPlan
+const exportQueryPlans = `
+SELECT organization,
+       id,
+       alias,
+       query_fingerprint,
+       queryid,
+       plan,
+       original_plan,
+       query,
+       database,
+       username,
+       cluster,
+       period_start,
+       optimization_id
+FROM plans
+WHERE organization = :organization
+  AND cluster = :cluster_name
+  AND period_start >= :period_start_from
+  AND period_start <= :period_start_to
+ORDER BY period_start`
+
 const insertQueryPlan = `
   INSERT INTO plans
   (
+   organization,
 	id, 
    alias,
    query_fingerprint,
@@ -72,6 +142,7 @@ const insertQueryPlan = `
    optimization_id
    )
 VALUES (
+    :organization,
     :id,
 	:alias,
 	:query_fingerprint,
@@ -87,7 +158,7 @@ VALUES (
   )
 `
 
-func (ar Repository) SaveQueryPlan(ctx context.Context, entity PlanEntity) error {
+func (ar sqlRepository) SaveQueryPlan(ctx context.Context, entity PlanEntity) error {
 	query, args, err := ar.DB.BindNamed(insertQueryPlan, entity)
 	if err != nil {
 		return fmt.Errorf("could not BindNamed for insertQueryPlan with PlanEntity: %v", err)
@@ -103,16 +174,16 @@ func (ar Repository) SaveQueryPlan(ctx context.Context, entity PlanEntity) error
 const getPlansTmpl = `
 SELECT id, alias, period_start, query, optimization_id 
 FROM plans 
-WHERE cluster = :cluster
-ORDER BY :order_by {{ .OrderDir }} 
+WHERE organization = :organization AND cluster = :cluster
+ORDER BY {{ .OrderBy }} {{ .OrderDir }} 
 LIMIT :limit
 `
 
-func (ar Repository) GetPlansList(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error) {
+func (ar sqlRepository) GetPlansList(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error) {
 	return ar.getPlansList(ctx, request, getPlansTmpl)
 }
 
-func (ar Repository) getPlansList(ctx context.Context, request PlansSearchRequest, queryTemplate string) ([]PlanEntity, error) {
+func (ar sqlRepository) getPlansList(ctx context.Context, request PlansSearchRequest, queryTemplate string) ([]PlanEntity, error) {
 	query, queryArgs, err := shared.ProcessQueryWithTemplate(request.ToTmplArgs(), request.ToQueryArgs(), queryTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
@@ -146,11 +217,11 @@ func (ar Repository) getPlansList(ctx context.Context, request PlansSearchReques
 const getOptimizationsTmpl = `
 SELECT id, alias, period_start, query, optimization_id, query_fingerprint
 FROM plans 
-WHERE cluster = :cluster AND (query_fingerprint = :query_fingerprint OR optimization_id = :optimization_id)
-ORDER BY :order_by {{ .OrderDir }} 
+WHERE organization = :organization AND cluster = :cluster AND (query_fingerprint = :query_fingerprint OR optimization_id = :optimization_id)
+ORDER BY {{ .OrderBy }} {{ .OrderDir }} 
 LIMIT :limit
 `
 
-func (ar Repository) GetOptimizations(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error) {
+func (ar sqlRepository) GetOptimizations(ctx context.Context, request PlansSearchRequest) ([]PlanEntity, error) {
 	return ar.getPlansList(ctx, request, getOptimizationsTmpl)
 }
@@ -11,6 +11,7 @@ import (
 	pg_query "github.com/pganalyze/pg_query_go/v4"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/backend/organization"
 	"postgres-explain/backend/shared"
 	"postgres-explain/core/pkg"
 	"postgres-explain/proto"
@@ -27,12 +28,12 @@ type Service struct {
 
 func (aps *Service) GetQueryPlansList(ctx context.Context, request *proto.GetQueryPlansListRequest) (*proto.GetQueryPlansListResponse, error) {
 	list, err := aps.Repo.GetPlansList(ctx, PlansSearchRequest{
-		PeriodStartFrom:  request.PeriodStartFrom.AsTime(),
-		PeriodStartTo:    request.PeriodStartTo.AsTime(),
-		ClusterName:      request.ClusterName,
-		Limit:            int(request.Limit),
-		Order:            request.Order,
-		QueryFingerprint: request.QueryFingerprint,
+		Organization:    organization.FromContext(ctx),
+		PeriodStartFrom: request.PeriodStartFrom.AsTime(),
+		PeriodStartTo:   request.PeriodStartTo.AsTime(),
+		ClusterName:     request.ClusterName,
+		Limit:           int(request.Limit),
+		Order:           request.Order,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not GetPlansList: %v", err)
@@ -52,6 +53,10 @@ func (aps *Service) GetQueryPlansList(ctx context.Context, request *proto.GetQue
 }
 
 func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryPlanRequest) (*proto.SaveQueryPlanResponse, error) {
+	if err := aps.validateSaveRequest(request); err != nil {
+		return nil, fmt.Errorf("validation failed: %v", err)
+	}
+
 	pg := postgresql.V2{}
 	pgCreds, err := aps.credentialsProvider.GetPostgresCredentials(ctx, request.ClusterName, "", credentials.Options{})
 	if err != nil {
@@ -75,7 +80,6 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 
 	planRequest := PlanRequest{
 		Query:    request.Query,
-		QueryID:  request.QueryId,
 		Database: request.Database,
 	}
 	if len(request.Parameters) > 0 {
@@ -117,10 +121,10 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 	}
 
 	planEntity := PlanEntity{
+		Organization:     organization.FromContext(ctx),
 		Alias:            shared.ToSqlNullString(request.Alias),
 		Query:            planRequest.Query,
 		PlanID:           planId,
-		QueryID:          shared.ToSqlNullString(planRequest.QueryID),
 		QueryFingerprint: fingerprint,
 		OriginalPlan:     plan,
 		ClusterName:      request.ClusterName,
@@ -144,7 +148,7 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 }
 
 func (aps *Service) GetQueryPlan(ctx context.Context, request *proto.GetQueryPlanRequest) (*proto.GetQueryPlanResponse, error) {
-	plan, err := aps.Repo.GetQueryPlan(ctx, request.PlanId)
+	plan, err := aps.Repo.GetQueryPlan(ctx, organization.FromContext(ctx), request.PlanId)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +164,28 @@ func (aps *Service) GetQueryPlan(ctx context.Context, request *proto.GetQueryPla
 	}, err
 }
 
+// validateSaveRequest rejects the properties core mode cannot honour: without the activities
+// the query of a query_fingerprint or a query_sha cannot be looked up, so the query is required.
+func (aps *Service) validateSaveRequest(request *proto.SaveQueryPlanRequest) error {
+	if request.ClusterName == "" {
+		return fmt.Errorf("cluster_name is required")
+	}
+
+	if request.QueryFingerprint != "" || request.QuerySha != "" {
+		return fmt.Errorf("query_fingerprint and query_sha are not supported in core mode, use query and database instead")
+	}
+
+	if request.Query == "" {
+		return fmt.Errorf("query is required")
+	}
+
+	if request.Database == "" {
+		return fmt.Errorf("database is required to know where to run the query")
+	}
+
+	return nil
+}
+
 func (aps *Service) runExplain(
 	ctx context.Context,
 	conn *sqlx.DB,
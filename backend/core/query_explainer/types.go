@@ -7,7 +7,6 @@ import (
 
 type PlanRequest struct {
 	Query      string        `json:"query"`
-	QueryID    string        `json:"query_id"`
 	Database   string        `json:"datname"`
 	Parameters []interface{} `json:"parameters"`
 }
@@ -21,6 +20,7 @@ func (p *PlanRequest) paramsFromRequest(params []string) {
 }
 
 type PlanEntity struct {
+	Organization     string         `json:"organization"`
 	PlanID           string         `json:"id"`
 	OptimizationId   string         `json:"optimization_id"`
 	Alias            sql.NullString `json:"alias"`
@@ -35,7 +35,16 @@ type PlanEntity struct {
 	Username         string         `json:"username"`
 }
 
+func (p *PlanEntity) GetOrganization() string {
+	return p.Organization
+}
+
+func (p *PlanEntity) SetOrganization(organization string) {
+	p.Organization = organization
+}
+
 type PlansSearchRequest struct {
+	Organization     string    `json:"organization"`
 	PeriodStartFrom  time.Time `json:"period_start_from"`
 	PeriodStartTo    time.Time `json:"period_start_to"`
 	ClusterName      string    `json:"cluster_name"`
@@ -46,21 +55,13 @@ type PlansSearchRequest struct {
 }
 
 func (r PlansSearchRequest) ToQueryArgs() map[string]interface{} {
-	orderByMap := map[string]string{
-		"latest": "period_start",
-		"oldest": "period_start",
-	}
-
-	if r.Order == "" {
-		r.Order = "latest"
-	}
 	if r.Limit == 0 {
 		r.Limit = 100
 	}
 
 	m := map[string]interface{}{
+		"organization":      r.Organization,
 		"cluster":           r.ClusterName,
-		"order_by":          orderByMap[r.Order],
 		"limit":             r.Limit,
 		"query_fingerprint": r.QueryFingerprint,
 		"optimization_id":   r.OptimizationId,
@@ -72,6 +73,12 @@ func (r PlansSearchRequest) ToQueryArgs() map[string]interface{} {
 func (r PlansSearchRequest) ToTmplArgs() interface{} {
 	type tmplArgs struct {
 		OrderDir string
+		OrderBy  string
+	}
+
+	orderByMap := map[string]string{
+		"latest": "period_start",
+		"oldest": "period_start",
 	}
 	orderDirMap := map[string]string{
 		"latest": "DESC",
@@ -84,5 +91,6 @@ func (r PlansSearchRequest) ToTmplArgs() interface{} {
 
 	return tmplArgs{
 		OrderDir: orderDirMap[r.Order],
+		OrderBy:  orderByMap[r.Order],
 	}
 }
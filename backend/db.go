@@ -8,8 +8,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ClickHouse/clickhouse-go/v2" // register database/sql driver
-	"github.com/golang-migrate/migrate"
+	"github.com/ClickHouse/clickhouse-go/v2"                  // register database/sql driver
 	_ "github.com/golang-migrate/migrate/database/clickhouse" // register golang-migrate driver
 	_ "github.com/golang-migrate/migrate/source/file"
 	"github.com/jmoiron/sqlx"
@@ -21,24 +20,27 @@ const (
 )
 
 // NewDB return updated db.
-func NewDB(dsn string, maxIdleConns, maxOpenConns int, log *logrus.Entry, migrationFolder string) *sqlx.DB {
+// If skipMigrations is true the migrations are not applied, but the schema version is still checked.
+func NewDB(dsn string, maxIdleConns, maxOpenConns int, log *logrus.Entry, migrationFolder string, skipMigrations bool) *sqlx.DB {
 	db, err := connect(dsn, log)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	// TODO: find solution with better performance
-	db.Mapper = reflectx.NewMapperTagFunc("json", strings.ToUpper, func(value string) string {
-		if strings.Contains(value, ",") {
-			return strings.Split(value, ",")[0]
-		}
-		return value
-	})
+	db.Mapper = newMapper()
 
 	db.SetConnMaxLifetime(0)
 	db.SetMaxIdleConns(maxIdleConns)
 	db.SetMaxOpenConns(maxOpenConns)
 
+	if skipMigrations {
+		if err := checkMigrations(dsn, migrationFolder); err != nil {
+			log.Fatal("Migrations: ", err)
+		}
+		log.Infof("Migrations skipped.")
+		return db
+	}
+
 	if err := runMigrations(dsn, log, migrationFolder); err != nil {
 		log.Fatal("Migrations: ", err)
 	}
@@ -46,6 +48,17 @@ func NewDB(dsn string, maxIdleConns, maxOpenConns int, log *logrus.Entry, migrat
 	return db
 }
 
+// newMapper maps the struct fields to the columns using the json tags
+func newMapper() *reflectx.Mapper {
+	// TODO: find solution with better performance
+	return reflectx.NewMapperTagFunc("json", strings.ToUpper, func(value string) string {
+		if strings.Contains(value, ",") {
+			return strings.Split(value, ",")[0]
+		}
+		return value
+	})
+}
+
 func createDB(dsn string, log *logrus.Entry) error {
 	log.Infof("Creating database")
 	parsedDSN, err := clickhouse.ParseDSN(dsn)
@@ -121,41 +134,3 @@ func connect(dsn string, log *logrus.Entry) (db *sqlx.DB, err error) {
 
 	return db, err
 }
-
-func runMigrations(dsn string, log *logrus.Entry, migrationFolder string) error {
-	log.Infof("dsn: %v", dsn)
-	m, err := migrate.New(fmt.Sprintf("file://%v", migrationFolder), dsn)
-	if err != nil {
-		return err
-	}
-
-	// run up to the latest migration
-	err = m.Up()
-	if err == migrate.ErrNoChange {
-		return nil
-	}
-	return err
-}
-
-// DropOldPartition drops number of days old partitions.
-func DropOldPartition(db *sqlx.DB, table string, days uint, log *logrus.Entry) {
-	partitions := []string{}
-	const query = `
-		SELECT DISTINCT partition
-		FROM system.parts
-		WHERE toUInt32(partition) < toYYYYMMDD(now() - toIntervalDay(?)) ORDER BY partition
-	`
-	err := db.Select(
-		&partitions,
-		query,
-		days,
-	)
-	if err != nil {
-		log.Errorf("Select %d days old partitions of system.parts. Result: %v, Error: %v", days, partitions, err)
-		return
-	}
-	for _, part := range partitions {
-		result, err := db.Exec(fmt.Sprintf(`ALTER TABLE %v DROP PARTITION %s`, table, part))
-		log.Infof("Drop %s partitions of %v. Result: %v, Error: %v", part, table, result, err)
-	}
-}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"os/signal"
+	"postgres-explain/backend/admin"
+	"postgres-explain/backend/modules"
+	"postgres-explain/backend/organization"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var (
+	exportCommand = kingpin.Command("export", "export plans, analytics and activities of a cluster as NDJSON")
+	exportCluster = exportCommand.Flag("cluster", "name of the cluster to export").
+			Required().
+			String()
+	exportOrganization = exportCommand.Flag("organization", "organization of the cluster to export").
+				Default(organization.Default).
+				String()
+	exportFrom = exportCommand.Flag("from", "export data from this date (RFC3339)").
+			Required().
+			String()
+	exportTo = exportCommand.Flag("to", "export data until this date (RFC3339), defaults to now").
+			String()
+	exportTables = exportCommand.Flag("table", "table to export, can be repeated, defaults to every table").
+			Strings()
+	exportOutput = exportCommand.Flag("output", "file to write, defaults to stdout").
+			Short('o').
+			String()
+	exportGzip = exportCommand.Flag("gzip", "compress the dump with gzip").
+			Bool()
+
+	importCommand = kingpin.Command("import", "import a NDJSON dump created with export")
+	importInput   = importCommand.Flag("input", "dump to import, gzipped dumps are detected automatically").
+			Short('i').
+			Required().
+			String()
+	importOrganization = importCommand.Flag("organization", "organization of the imported rows, defaults to the organization stored in the dump").
+				String()
+	importProgressFile = importCommand.Flag("progress-file", "file keeping the imported lines to resume an interrupted import, defaults to <input>.progress").
+				String()
+)
+
+// getTables returns the tables of every module, sorted by name
+func getTables(modulesMap map[string]modules.Module) []modules.Table {
+	tables := make([]modules.Table, 0)
+	for _, module := range modulesMap {
+		if owner, ok := module.(modules.TablesOwner); ok {
+			tables = append(tables, owner.Tables()...)
+		}
+	}
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name < tables[j].Name
+	})
+
+	return tables
+}
+
+func newDumper(log *logrus.Entry) admin.Dumper {
+	modulesMap, err := GetModules()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return admin.Dumper{DB: openStorage(log), Tables: getTables(modulesMap), Log: log}
+}
+
+// cancelOnSignal returns a context canceled on SIGTERM or SIGINT
+func cancelOnSignal(log *logrus.Entry) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, unix.SIGTERM, unix.SIGINT)
+	go func() {
+		select {
+		case s := <-signals:
+			log.Warnf("Got %s, stopping...", unix.SignalName(s.(unix.Signal)))
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
+func runExport(log *logrus.Entry) {
+	from, err := time.Parse(time.RFC3339, *exportFrom)
+	if err != nil {
+		log.Fatalf("could not parse --from: %v", err)
+	}
+	to := time.Now()
+	if *exportTo != "" {
+		if to, err = time.Parse(time.RFC3339, *exportTo); err != nil {
+			log.Fatalf("could not parse --to: %v", err)
+		}
+	}
+
+	ctx, cancel := cancelOnSignal(log)
+	defer cancel()
+
+	dumper := newDumper(log)
+
+	var output io.WriteCloser = os.Stdout
+	if *exportOutput != "" {
+		if output, err = os.Create(*exportOutput); err != nil {
+			log.Fatalf("could not create output file: %v", err)
+		}
+	}
+
+	var w io.Writer = output
+	var gzipWriter *gzip.Writer
+	if *exportGzip {
+		gzipWriter = gzip.NewWriter(output)
+		w = gzipWriter
+	}
+
+	lines, err := dumper.Export(ctx, admin.DumpArgs{
+		Organization:    *exportOrganization,
+		ClusterName:     *exportCluster,
+		PeriodStartFrom: from,
+		PeriodStartTo:   to,
+		Tables:          *exportTables,
+	}, w)
+	if err != nil {
+		log.Fatalf("could not export after %d lines: %v", lines, err)
+	}
+
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			log.Fatalf("could not close gzip writer: %v", err)
+		}
+	}
+	if err := output.Close(); err != nil {
+		log.Fatalf("could not close output: %v", err)
+	}
+
+	log.Infof("Exported %d lines", lines)
+}
+
+func runImport(log *logrus.Entry) {
+	progressFile := *importProgressFile
+	if progressFile == "" {
+		progressFile = *importInput + ".progress"
+	}
+
+	skipLines, err := readProgress(progressFile)
+	if err != nil {
+		log.Fatalf("could not read progress file: %v", err)
+	}
+	if skipLines > 0 {
+		log.Infof("Resuming import, skipping %d lines already imported", skipLines)
+	}
+
+	input, err := os.Open(*importInput)
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer input.Close()
+
+	ctx, cancel := cancelOnSignal(log)
+	defer cancel()
+
+	dumper := newDumper(log)
+	imported, err := dumper.Import(ctx, input, *importOrganization, skipLines, func(lines uint64) error {
+		return os.WriteFile(progressFile, []byte(strconv.FormatUint(lines, 10)), 0644)
+	})
+	if err != nil {
+		log.Fatalf("import interrupted after %d lines, run the same command again to resume it: %v", imported, err)
+	}
+
+	if err := os.Remove(progressFile); err != nil && !os.IsNotExist(err) {
+		log.Warnf("could not remove progress file: %v", err)
+	}
+
+	log.Infof("Imported %d lines", imported)
+}
+
+func readProgress(progressFile string) (uint64, error) {
+	progress, err := os.ReadFile(progressFile)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	lines, err := strconv.ParseUint(strings.TrimSpace(string(progress)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("progress file %v is not valid: %v", progressFile, err)
+	}
+
+	return lines, nil
+}
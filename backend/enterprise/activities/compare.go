@@ -0,0 +1,129 @@
+package activities
+
+import (
+	"math"
+	"postgres-explain/proto"
+	"sort"
+)
+
+const (
+	fingerprintStatusNew     = "new"
+	fingerprintStatusGone    = "gone"
+	fingerprintStatusChanged = "changed"
+)
+
+// WaitEventLoadDB is the average active sessions of a wait event over a window
+type WaitEventLoadDB struct {
+	WaitEvent string  `json:"wait_event"`
+	AAS       float64 `json:"aas"`
+}
+
+// FingerprintLoadDB is the average active sessions of a fingerprint over the baseline window and the compared window
+type FingerprintLoadDB struct {
+	Fingerprint string  `json:"fingerprint"`
+	BaselineAAS float64 `json:"baseline_aas"`
+	AAS         float64 `json:"aas"`
+}
+
+// percentChange returns the change of the value relative to the baseline, a rise from a zero baseline is 100%
+func percentChange(baseline, value float32) float32 {
+	switch {
+	case baseline == value:
+		return 0
+	case baseline == 0:
+		return 100
+	default:
+		return (value - baseline) / baseline * 100
+	}
+}
+
+func newLoadDelta(baseline, value float32) *proto.LoadDelta {
+	return &proto.LoadDelta{
+		BaselineAas:   baseline,
+		Aas:           value,
+		Delta:         value - baseline,
+		ChangePercent: percentChange(baseline, value),
+	}
+}
+
+// compareWaitEvents returns the deltas of each wait event of the windows and the delta of their total
+func compareWaitEvents(baseline, current []WaitEventLoadDB) (map[string]*proto.LoadDelta, *proto.LoadDelta) {
+	baselineAAS := make(map[string]float32, len(baseline))
+	var baselineTotal float32
+	for _, load := range baseline {
+		baselineAAS[load.WaitEvent] += float32(load.AAS)
+		baselineTotal += float32(load.AAS)
+	}
+	currentAAS := make(map[string]float32, len(current))
+	var currentTotal float32
+	for _, load := range current {
+		currentAAS[load.WaitEvent] += float32(load.AAS)
+		currentTotal += float32(load.AAS)
+	}
+
+	deltas := make(map[string]*proto.LoadDelta)
+	for waitEvent, aas := range currentAAS {
+		deltas[waitEvent] = newLoadDelta(baselineAAS[waitEvent], aas)
+	}
+	for waitEvent, aas := range baselineAAS {
+		if _, ok := currentAAS[waitEvent]; !ok {
+			deltas[waitEvent] = newLoadDelta(aas, 0)
+		}
+	}
+
+	return deltas, newLoadDelta(baselineTotal, currentTotal)
+}
+
+// compareFingerprints returns the deltas of the fingerprints of both windows, the biggest change first.
+// A fingerprint without samples in one of the windows is new or gone.
+func compareFingerprints(loads []FingerprintLoadDB) []*proto.FingerprintDelta {
+	deltas := make([]*proto.FingerprintDelta, 0, len(loads))
+	for _, load := range loads {
+		status := fingerprintStatusChanged
+		switch {
+		case load.BaselineAAS == 0:
+			status = fingerprintStatusNew
+		case load.AAS == 0:
+			status = fingerprintStatusGone
+		}
+		deltas = append(deltas, &proto.FingerprintDelta{
+			Fingerprint: load.Fingerprint,
+			Load:        newLoadDelta(float32(load.BaselineAAS), float32(load.AAS)),
+			Status:      status,
+		})
+	}
+
+	sort.SliceStable(deltas, func(i, j int) bool {
+		return math.Abs(float64(deltas[i].Load.Delta)) > math.Abs(float64(deltas[j].Load.Delta))
+	})
+
+	return deltas
+}
+
+func compareMetricValues(baseline, current *proto.MetricValues) *proto.MetricValuesChange {
+	return &proto.MetricValuesChange{
+		Rate: percentChange(baseline.GetRate(), current.GetRate()),
+		Cnt:  percentChange(baseline.GetCnt(), current.GetCnt()),
+		Sum:  percentChange(baseline.GetSum(), current.GetSum()),
+		Min:  percentChange(baseline.GetMin(), current.GetMin()),
+		Max:  percentChange(baseline.GetMax(), current.GetMax()),
+		Avg:  percentChange(baseline.GetAvg(), current.GetAvg()),
+		P99:  percentChange(baseline.GetP99(), current.GetP99()),
+	}
+}
+
+// compareQueriesMetrics returns the percentage changes of the metrics of the queries of the period
+func compareQueriesMetrics(baseline, current map[string]*proto.QueriesMetrics) map[string]*proto.QueriesMetricsChange {
+	changes := make(map[string]*proto.QueriesMetricsChange, len(current))
+	for fingerprint, queryMetrics := range current {
+		baselineMetrics := baseline[fingerprint].GetMetrics()
+
+		metricsChanges := make(map[string]*proto.MetricValuesChange, len(queryMetrics.GetMetrics()))
+		for name, values := range queryMetrics.GetMetrics() {
+			metricsChanges[name] = compareMetricValues(baselineMetrics[name], values)
+		}
+		changes[fingerprint] = &proto.QueriesMetricsChange{Metrics: metricsChanges}
+	}
+
+	return changes
+}
@@ -0,0 +1,79 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/proto"
+	"testing"
+)
+
+func TestPercentChange(t *testing.T) {
+	assert.Equal(t, float32(50), percentChange(2, 3))
+	assert.Equal(t, float32(-100), percentChange(2, 0))
+	assert.Equal(t, float32(100), percentChange(0, 3))
+	assert.Equal(t, float32(0), percentChange(0, 0))
+}
+
+func TestCompareWaitEvents(t *testing.T) {
+	deltas, total := compareWaitEvents(
+		[]WaitEventLoadDB{{WaitEvent: "CPU", AAS: 1}, {WaitEvent: "WALWrite", AAS: 1}},
+		[]WaitEventLoadDB{{WaitEvent: "CPU", AAS: 2}, {WaitEvent: "DataFileRead", AAS: 1}},
+	)
+
+	assert.Len(t, deltas, 3)
+	assert.Equal(t, float32(1), deltas["CPU"].Delta)
+	assert.Equal(t, float32(100), deltas["CPU"].ChangePercent)
+	assert.Equal(t, float32(-1), deltas["WALWrite"].Delta)
+	assert.Equal(t, float32(0), deltas["DataFileRead"].BaselineAas)
+	assert.Equal(t, float32(2), total.BaselineAas)
+	assert.Equal(t, float32(3), total.Aas)
+}
+
+func TestCompareFingerprints(t *testing.T) {
+	deltas := compareFingerprints([]FingerprintLoadDB{
+		{Fingerprint: "a", BaselineAAS: 1, AAS: 1.25},
+		{Fingerprint: "gone", BaselineAAS: 0.5},
+		{Fingerprint: "new", AAS: 2},
+	})
+
+	assert.Len(t, deltas, 3)
+	assert.Equal(t, "new", deltas[0].Fingerprint)
+	assert.Equal(t, fingerprintStatusNew, deltas[0].Status)
+	assert.Equal(t, "gone", deltas[1].Fingerprint)
+	assert.Equal(t, fingerprintStatusGone, deltas[1].Status)
+	assert.Equal(t, float32(-0.5), deltas[1].Load.Delta)
+	assert.Equal(t, fingerprintStatusChanged, deltas[2].Status)
+	assert.Equal(t, float32(25), deltas[2].Load.ChangePercent)
+}
+
+func TestCompareQueriesMetrics(t *testing.T) {
+	changes := compareQueriesMetrics(
+		map[string]*proto.QueriesMetrics{"a": {Metrics: map[string]*proto.MetricValues{"m_query_time": {Sum: 10, Avg: 2}}}},
+		map[string]*proto.QueriesMetrics{
+			"a":   {Metrics: map[string]*proto.MetricValues{"m_query_time": {Sum: 15, Avg: 1}}},
+			"new": {Metrics: map[string]*proto.MetricValues{"m_query_time": {Sum: 5}}},
+		},
+	)
+
+	assert.Equal(t, float32(50), changes["a"].Metrics["m_query_time"].Sum)
+	assert.Equal(t, float32(-50), changes["a"].Metrics["m_query_time"].Avg)
+	assert.Equal(t, float32(100), changes["new"].Metrics["m_query_time"].Sum)
+}
+
+func TestGetBaselineArgs(t *testing.T) {
+	args := QueryArgs{ClusterName: "cluster", PeriodStartFromSec: 86400, PeriodStartToSec: 90000}
+
+	baselineArgs, err := getBaselineArgs(args, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, baselineArgs)
+
+	baselineArgs, err = getBaselineArgs(args, &timestamppb.Timestamp{Seconds: 0}, &timestamppb.Timestamp{Seconds: 3600})
+	assert.NoError(t, err)
+	assert.Equal(t, QueryArgs{ClusterName: "cluster", PeriodStartFromSec: 0, PeriodStartToSec: 3600}, *baselineArgs)
+
+	_, err = getBaselineArgs(args, &timestamppb.Timestamp{Seconds: 0}, nil)
+	assert.Error(t, err)
+
+	_, err = getBaselineArgs(args, &timestamppb.Timestamp{Seconds: 3600}, &timestamppb.Timestamp{Seconds: 0})
+	assert.Error(t, err)
+}
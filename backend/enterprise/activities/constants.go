@@ -1,3 +0,0 @@
-package activities
-
-const timeElapsed = 60
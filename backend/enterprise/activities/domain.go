@@ -4,14 +4,11 @@ import (
 	"time"
 )
 
+// Slot holds the average active sessions of each wait event of a slot
 type Slot map[string]float32
 type Slots map[time.Time]Slot
 
 func (s Slot) GetAAS() float32 {
-	return s.GetTotalDBTime() / timeElapsed
-}
-
-func (s Slot) GetTotalDBTime() float32 {
 	var total float32 = 0
 	for _, value := range s {
 		total = total + value
@@ -21,5 +18,14 @@ func (s Slot) GetTotalDBTime() float32 {
 }
 
 func (s Slot) GetWaitEventFraction(waitEventName string) float32 {
-	return s[waitEventName] / timeElapsed
+	return s[waitEventName]
+}
+
+// GetAAS returns the average active sessions of the slot, the database time of its samples spread over its width.
+func (s SlotDB) GetAAS() float32 {
+	if s.SlotLength == 0 {
+		return 0
+	}
+
+	return float32(s.DBTimeSecs) / float32(s.SlotLength)
 }
@@ -2,28 +2,56 @@ package activities
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 )
 
+const (
+	waitEventsFileName = "wait_events.json"
+	// customWaitEventsFileName holds the wait events of the extensions, it is optional
+	customWaitEventsFileName = "custom_wait_events.json"
+)
+
 type WaitEvent struct {
 	Type        string `json:"type"`
 	Class       string `json:"class"`
 	Description string `json:"description"`
 	Color       string `json:"color"`
+	// MinVersion and MaxVersion are the first and the last major versions of PostgreSQL reporting the wait event,
+	// 0 when it is reported by every supported version
+	MinVersion uint32 `json:"min_version,omitempty"`
+	MaxVersion uint32 `json:"max_version,omitempty"`
+}
+
+// LoadWaitEventCatalog loads the wait events of the directory and the custom wait events of the extensions next to them
+func LoadWaitEventCatalog(dir string) (*WaitEventCatalog, error) {
+	waitEvents, err := loadWaitEventsFile(filepath.Join(dir, waitEventsFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	customWaitEvents, err := loadWaitEventsFile(filepath.Join(dir, customWaitEventsFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		customWaitEvents = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return NewWaitEventCatalog(waitEvents, customWaitEvents), nil
 }
 
-func LoadWaitEventsMapFromFile(dir string) map[string]WaitEvent {
-	file, err := os.ReadFile(filepath.Join(dir, "wait_events.json"))
+func loadWaitEventsFile(path string) (map[string]WaitEvent, error) {
+	file, err := os.ReadFile(path)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, fmt.Errorf("could not ReadFile %v: %w", path, err)
 	}
 
 	var pgWaitEvents map[string]WaitEvent
 	if err := json.Unmarshal(file, &pgWaitEvents); err != nil {
-		log.Fatalln(err)
+		return nil, fmt.Errorf("could not Unmarshal %v: %v", path, err)
 	}
 
-	return pgWaitEvents
+	return pgWaitEvents, nil
 }
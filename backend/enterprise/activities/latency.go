@@ -0,0 +1,114 @@
+package activities
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"postgres-explain/proto"
+	"sort"
+	"time"
+)
+
+const (
+	// minLatencySecs is the upper bound of the first bucket of the latency histogram, 1ms
+	minLatencySecs = 0.001
+	// latencyBuckets is the number of buckets of the latency histogram, the last one holds the latencies above 17 minutes
+	latencyBuckets = 22
+)
+
+// LatencyDB is the number of executions of a slot in a bucket of the latency histogram
+type LatencyDB struct {
+	Slot       time.Time `json:"slot"`
+	Bucket     uint32    `json:"bucket"`
+	Executions uint64    `json:"executions"`
+}
+
+// latencyBucketBounds returns the bounds of a bucket of the histogram, the first bucket starts at 0
+// and the upper bound of the last one is 0 since it is unbounded
+func latencyBucketBounds(bucket uint32) (float64, float64) {
+	var lower, upper float64
+	if bucket > 0 {
+		lower = minLatencySecs * math.Pow(2, float64(bucket-1))
+	}
+	if bucket < latencyBuckets-1 {
+		upper = minLatencySecs * math.Pow(2, float64(bucket))
+	}
+
+	return lower, upper
+}
+
+// toLatencyHistogram sums the executions of every slot by bucket, all the buckets are returned
+func toLatencyHistogram(latencies []LatencyDB) ([]*proto.LatencyBucket, uint64) {
+	buckets := make([]*proto.LatencyBucket, latencyBuckets)
+	for i := range buckets {
+		lower, upper := latencyBucketBounds(uint32(i))
+		buckets[i] = &proto.LatencyBucket{LowerBoundSecs: float32(lower), UpperBoundSecs: float32(upper)}
+	}
+
+	var executions uint64
+	for _, latency := range latencies {
+		if latency.Bucket >= latencyBuckets {
+			continue
+		}
+		buckets[latency.Bucket].Executions += latency.Executions
+		executions += latency.Executions
+	}
+
+	return buckets, executions
+}
+
+// latencyPercentile returns the upper bound of the bucket holding the percentile of the executions,
+// the lower bound for the last bucket
+func latencyPercentile(buckets []*proto.LatencyBucket, executions uint64, percentile float64) float32 {
+	if executions == 0 {
+		return 0
+	}
+
+	rank := uint64(math.Ceil(percentile * float64(executions)))
+	var seen uint64
+	for _, bucket := range buckets {
+		seen += bucket.Executions
+		if seen >= rank {
+			if bucket.UpperBoundSecs == 0 {
+				return bucket.LowerBoundSecs
+			}
+			return bucket.UpperBoundSecs
+		}
+	}
+
+	return buckets[len(buckets)-1].LowerBoundSecs
+}
+
+// toLatencyHeatmap returns the executions of each bucket by slot, the slots are ordered by time
+func toLatencyHeatmap(latencies []LatencyDB) *proto.LatencyHeatmap {
+	slotIndexes := make(map[time.Time]int)
+	for _, latency := range latencies {
+		slotIndexes[latency.Slot] = 0
+	}
+	slots := make([]time.Time, 0, len(slotIndexes))
+	for slot := range slotIndexes {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Before(slots[j])
+	})
+
+	heatmap := &proto.LatencyHeatmap{
+		XValuesTimestamp: make([]*timestamppb.Timestamp, 0, len(slots)),
+		Rows:             make([]*proto.LatencyHeatmapRow, latencyBuckets),
+	}
+	for i, slot := range slots {
+		slotIndexes[slot] = i
+		heatmap.XValuesTimestamp = append(heatmap.XValuesTimestamp, timestamppb.New(slot))
+	}
+	for i := range heatmap.Rows {
+		heatmap.Rows[i] = &proto.LatencyHeatmapRow{Executions: make([]uint64, len(slots))}
+	}
+	for _, latency := range latencies {
+		if latency.Bucket >= latencyBuckets {
+			continue
+		}
+		heatmap.Rows[latency.Bucket].Executions[slotIndexes[latency.Slot]] += latency.Executions
+	}
+
+	return heatmap
+}
@@ -0,0 +1,55 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLatencyBucketBounds(t *testing.T) {
+	tests := []struct {
+		bucket uint32
+		lower  float64
+		upper  float64
+	}{
+		{bucket: 0, lower: 0, upper: 0.001},
+		{bucket: 1, lower: 0.001, upper: 0.002},
+		{bucket: 11, lower: 1.024, upper: 2.048},
+		{bucket: latencyBuckets - 1, lower: 1048.576, upper: 0},
+	}
+	for _, tt := range tests {
+		lower, upper := latencyBucketBounds(tt.bucket)
+		assert.InDelta(t, tt.lower, lower, 1e-9)
+		assert.InDelta(t, tt.upper, upper, 1e-9)
+	}
+}
+
+func TestLatencyDistribution(t *testing.T) {
+	first := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	latencies := []LatencyDB{
+		{Slot: second, Bucket: 11, Executions: 1},
+		{Slot: first, Bucket: 1, Executions: 90},
+		{Slot: first, Bucket: 11, Executions: 5},
+		{Slot: second, Bucket: latencyBuckets - 1, Executions: 4},
+	}
+
+	buckets, executions := toLatencyHistogram(latencies)
+	assert.Len(t, buckets, latencyBuckets)
+	assert.Equal(t, uint64(100), executions)
+	assert.Equal(t, uint64(90), buckets[1].Executions)
+	assert.Equal(t, uint64(6), buckets[11].Executions)
+
+	assert.Equal(t, float32(0.002), latencyPercentile(buckets, executions, 0.5))
+	assert.Equal(t, float32(2.048), latencyPercentile(buckets, executions, 0.95))
+	assert.Equal(t, float32(1048.576), latencyPercentile(buckets, executions, 0.99))
+	assert.Equal(t, float32(0), latencyPercentile(buckets, 0, 0.99))
+
+	heatmap := toLatencyHeatmap(latencies)
+	assert.Len(t, heatmap.XValuesTimestamp, 2)
+	assert.Equal(t, first, heatmap.XValuesTimestamp[0].AsTime())
+	assert.Len(t, heatmap.Rows, latencyBuckets)
+	assert.Equal(t, []uint64{90, 0}, heatmap.Rows[1].Executions)
+	assert.Equal(t, []uint64{5, 1}, heatmap.Rows[11].Executions)
+	assert.Equal(t, []uint64{0, 4}, heatmap.Rows[latencyBuckets-1].Executions)
+}
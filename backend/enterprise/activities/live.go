@@ -0,0 +1,159 @@
+package activities
+
+import (
+	"fmt"
+	"postgres-explain/proto"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// liveBufferedBatches is the number of batches waiting to be sent to a stream, the next ones are dropped
+	liveBufferedBatches = 16
+	// liveHeartbeatInterval is the time between two empty batches sent to an idle stream, so that the proxies keep it open
+	liveHeartbeatInterval = 15 * time.Second
+)
+
+// sampleDimensions returns the value of the columns of filterDimensions for a sample
+var sampleDimensions = map[string]func(sample *proto.ActivitySample) string{
+	"datname":          func(sample *proto.ActivitySample) string { return sample.Datname },
+	"usename":          func(sample *proto.ActivitySample) string { return sample.Usename },
+	"application_name": func(sample *proto.ActivitySample) string { return sample.ApplicationName },
+	"client_hostname":  func(sample *proto.ActivitySample) string { return sample.ClientHostname },
+	"instance_name":    func(sample *proto.ActivitySample) string { return sample.InstanceName },
+	"backend_type":     func(sample *proto.ActivitySample) string { return sample.BackendType },
+	"state":            func(sample *proto.ActivitySample) string { return sample.State },
+	"wait_event_type":  func(sample *proto.ActivitySample) string { return sample.WaitEventType },
+}
+
+// matchFilters returns whether a sample is kept by the filters, like dimensionFiltersSQL
+func matchFilters(sample *proto.ActivitySample, filters []DimensionFilter) bool {
+	for _, filter := range filters {
+		value := sampleDimensions[filter.Column](sample)
+
+		found := false
+		for _, filterValue := range filter.Values {
+			if value == filterValue {
+				found = true
+				break
+			}
+		}
+		if found == filter.Exclude {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseQueryFilters converts the filters of the query parameters, dimension:value or !dimension:value,
+// to the filters of the request body. The values of the same dimension and exclusion are merged, in their order.
+func parseQueryFilters(queryFilters []string) ([]*proto.DimensionFilter, error) {
+	filters := make([]*proto.DimensionFilter, 0, len(queryFilters))
+	for _, queryFilter := range queryFilters {
+		dimension, value, ok := strings.Cut(queryFilter, ":")
+		if !ok {
+			return nil, fmt.Errorf("filter %v is not valid, must be dimension:value or !dimension:value", queryFilter)
+		}
+		exclude := strings.HasPrefix(dimension, "!")
+		dimension = strings.TrimPrefix(dimension, "!")
+
+		var filter *proto.DimensionFilter
+		for _, f := range filters {
+			if f.Dimension == dimension && f.Exclude == exclude {
+				filter = f
+				break
+			}
+		}
+		if filter == nil {
+			filter = &proto.DimensionFilter{Dimension: dimension, Exclude: exclude}
+			filters = append(filters, filter)
+		}
+		filter.Values = append(filter.Values, value)
+	}
+
+	return filters, nil
+}
+
+// LiveSubscription receives the samples of a cluster matching its filters
+type LiveSubscription struct {
+	organization string
+	clusterName  string
+	filters      []DimensionFilter
+
+	batches chan []*proto.ActivitySample
+	dropped atomic.Uint64
+}
+
+// Batches returns the channel of the batches of the subscription
+func (s *LiveSubscription) Batches() <-chan []*proto.ActivitySample {
+	return s.batches
+}
+
+// Dropped returns the number of batches dropped because the subscriber did not keep up
+func (s *LiveSubscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// LiveBroker fans the batches received by the collector out to the live streams
+type LiveBroker struct {
+	mu            sync.RWMutex
+	subscriptions map[*LiveSubscription]struct{}
+}
+
+func NewLiveBroker() *LiveBroker {
+	return &LiveBroker{subscriptions: make(map[*LiveSubscription]struct{})}
+}
+
+// Subscribe returns a subscription to the samples of a cluster, it must be unsubscribed when the stream ends
+func (b *LiveBroker) Subscribe(organization, clusterName string, filters []DimensionFilter) *LiveSubscription {
+	subscription := &LiveSubscription{
+		organization: organization,
+		clusterName:  clusterName,
+		filters:      filters,
+		batches:      make(chan []*proto.ActivitySample, liveBufferedBatches),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+func (b *LiveBroker) Unsubscribe(subscription *LiveSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscriptions, subscription)
+}
+
+// Publish sends the samples of a batch to the subscriptions of their cluster, it never blocks the collector:
+// the batch is dropped for the subscriptions whose buffer is full
+func (b *LiveBroker) Publish(organization string, samples []*proto.ActivitySample) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscription := range b.subscriptions {
+		if subscription.organization != organization {
+			continue
+		}
+
+		matched := make([]*proto.ActivitySample, 0)
+		for _, sample := range samples {
+			if sample.ClusterName == subscription.clusterName && matchFilters(sample, subscription.filters) {
+				matched = append(matched, sample)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		select {
+		case subscription.batches <- matched:
+		default:
+			subscription.dropped.Add(1)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"postgres-explain/proto"
+	"testing"
+)
+
+func TestParseQueryFilters(t *testing.T) {
+	filters, err := parseQueryFilters([]string{"!user:etl", "database:shop", "!user:backup", "application_name:a:b"})
+	assert.NoError(t, err)
+	assert.Len(t, filters, 3)
+	assert.Equal(t, "user", filters[0].Dimension)
+	assert.True(t, filters[0].Exclude)
+	assert.Equal(t, []string{"etl", "backup"}, filters[0].Values)
+	assert.Equal(t, []string{"shop"}, filters[1].Values)
+	assert.False(t, filters[1].Exclude)
+	assert.Equal(t, []string{"a:b"}, filters[2].Values)
+
+	_, err = parseQueryFilters([]string{"database"})
+	assert.Error(t, err)
+}
+
+func TestMatchFilters(t *testing.T) {
+	sample := &proto.ActivitySample{Datname: "app", Usename: "api", State: "active", WaitEventType: "Lock"}
+
+	tests := []struct {
+		name    string
+		filters []DimensionFilter
+		want    bool
+	}{
+		{name: "no filters", filters: nil, want: true},
+		{name: "included", filters: []DimensionFilter{{Column: "datname", Values: []string{"app", "other"}}}, want: true},
+		{name: "not included", filters: []DimensionFilter{{Column: "datname", Values: []string{"other"}}}, want: false},
+		{name: "excluded", filters: []DimensionFilter{{Column: "state", Values: []string{"active"}, Exclude: true}}, want: false},
+		{name: "not excluded", filters: []DimensionFilter{{Column: "state", Values: []string{"idle"}, Exclude: true}}, want: true},
+		{
+			name: "every filter must match",
+			filters: []DimensionFilter{
+				{Column: "usename", Values: []string{"api"}},
+				{Column: "wait_event_type", Values: []string{"IO"}},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchFilters(sample, tt.filters))
+		})
+	}
+}
+
+func TestFilterDimensionsHaveSampleDimensions(t *testing.T) {
+	for dimension, column := range filterDimensions {
+		assert.Contains(t, sampleDimensions, column, "dimension %v has no sample getter", dimension)
+	}
+}
+
+func TestLiveBroker_Publish(t *testing.T) {
+	broker := NewLiveBroker()
+	subscription := broker.Subscribe("org", "main", []DimensionFilter{{Column: "datname", Values: []string{"app"}}})
+
+	broker.Publish("org", []*proto.ActivitySample{
+		{ClusterName: "main", Datname: "app", Pid: 1},
+		{ClusterName: "main", Datname: "postgres", Pid: 2},
+		{ClusterName: "other", Datname: "app", Pid: 3},
+	})
+	// the batches of another organization or without matching samples are not sent
+	broker.Publish("other", []*proto.ActivitySample{{ClusterName: "main", Datname: "app", Pid: 4}})
+	broker.Publish("org", []*proto.ActivitySample{{ClusterName: "main", Datname: "postgres", Pid: 5}})
+
+	assert.Len(t, subscription.Batches(), 1)
+	batch := <-subscription.Batches()
+	assert.Len(t, batch, 1)
+	assert.Equal(t, uint32(1), batch[0].Pid)
+
+	// the batches are dropped when the subscriber does not keep up
+	for i := 0; i < liveBufferedBatches+3; i++ {
+		broker.Publish("org", []*proto.ActivitySample{{ClusterName: "main", Datname: "app"}})
+	}
+	assert.Len(t, subscription.Batches(), liveBufferedBatches)
+	assert.Equal(t, uint64(3), subscription.Dropped())
+
+	broker.Unsubscribe(subscription)
+	assert.Empty(t, broker.subscriptions)
+}
@@ -0,0 +1,186 @@
+package activities
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/proto"
+	"sort"
+	"time"
+)
+
+// LockSampleDB is a sample of a session blocked by others or blocking others
+type LockSampleDB struct {
+	InstanceName     string    `json:"instance_name"`
+	CurrentTimestamp time.Time `json:"current_timestamp"`
+	PeriodLength     uint32    `json:"period_length"`
+	Pid              uint32    `json:"pid"`
+	BlockingPids     []uint32  `json:"blocking_pids"`
+	LockedRelation   string    `json:"locked_relation"`
+	LockMode         string    `json:"lock_mode"`
+	State            string    `json:"state"`
+	WaitEventType    string    `json:"wait_event_type"`
+	WaitEvent        string    `json:"wait_event"`
+	Usename          string    `json:"usename"`
+	ApplicationName  string    `json:"application_name"`
+	Fingerprint      string    `json:"fingerprint"`
+	Query            string    `json:"query"`
+}
+
+func (s LockSampleDB) toLockNode() *proto.LockNode {
+	return &proto.LockNode{
+		Pid:             s.Pid,
+		Usename:         s.Usename,
+		ApplicationName: s.ApplicationName,
+		State:           s.State,
+		WaitEventType:   s.WaitEventType,
+		WaitEvent:       s.WaitEvent,
+		Fingerprint:     s.Fingerprint,
+		Query:           s.Query,
+		LockedRelation:  s.LockedRelation,
+		LockMode:        s.LockMode,
+	}
+}
+
+// lockSnapshot holds the sessions of an instance sampled at the same time
+type lockSnapshot struct {
+	instanceName string
+	timestamp    time.Time
+	periodLength time.Duration
+	sessions     map[uint32]LockSampleDB
+	// blocked are the pids waiting for each pid
+	blocked map[uint32][]uint32
+}
+
+func newLockSnapshot(sample LockSampleDB) *lockSnapshot {
+	return &lockSnapshot{
+		instanceName: sample.InstanceName,
+		timestamp:    sample.CurrentTimestamp,
+		periodLength: time.Second,
+		sessions:     make(map[uint32]LockSampleDB),
+		blocked:      make(map[uint32][]uint32),
+	}
+}
+
+func (s *lockSnapshot) add(sample LockSampleDB) {
+	s.sessions[sample.Pid] = sample
+	if periodLength := time.Duration(sample.PeriodLength) * time.Second; periodLength > s.periodLength {
+		s.periodLength = periodLength
+	}
+	for _, blockingPid := range sample.BlockingPids {
+		s.blocked[blockingPid] = append(s.blocked[blockingPid], sample.Pid)
+	}
+}
+
+// heads returns the pids blocking others without being blocked, ordered by pid.
+// Sessions of a deadlock block each other, they have no head and are left to the deadlock detector.
+func (s *lockSnapshot) heads() []uint32 {
+	heads := make([]uint32, 0)
+	for pid := range s.blocked {
+		if len(s.sessions[pid].BlockingPids) == 0 {
+			heads = append(heads, pid)
+		}
+	}
+	sort.Slice(heads, func(i, j int) bool { return heads[i] < heads[j] })
+
+	return heads
+}
+
+// tree returns the sessions blocked by the pid and the number of them
+func (s *lockSnapshot) tree(pid uint32, visited map[uint32]bool) (*proto.LockNode, uint32) {
+	visited[pid] = true
+
+	node := s.sessions[pid].toLockNode()
+	// the blocker may not have been sampled
+	node.Pid = pid
+
+	var count uint32
+	blocked := append([]uint32(nil), s.blocked[pid]...)
+	sort.Slice(blocked, func(i, j int) bool { return blocked[i] < blocked[j] })
+	for _, blockedPid := range blocked {
+		if visited[blockedPid] {
+			continue
+		}
+		child, childCount := s.tree(blockedPid, visited)
+		node.Blocked = append(node.Blocked, child)
+		count += childCount + 1
+	}
+
+	return node, count
+}
+
+// lockChain is followed through the snapshots of its instance as long as its head blocks someone
+type lockChain struct {
+	firstSeen          time.Time
+	lastSeen           time.Time
+	periodLength       time.Duration
+	maxBlockedSessions uint32
+	head               *proto.LockNode
+	instanceName       string
+}
+
+func (c *lockChain) toProto() *proto.LockChain {
+	end := c.lastSeen.Add(c.periodLength)
+	return &proto.LockChain{
+		InstanceName:       c.instanceName,
+		FirstSeen:          timestamppb.New(c.firstSeen),
+		LastSeen:           timestamppb.New(c.lastSeen),
+		DurationSecs:       float32(end.Sub(c.firstSeen).Seconds()),
+		MaxBlockedSessions: c.maxBlockedSessions,
+		Head:               c.head,
+	}
+}
+
+// toLockSnapshots groups the samples ordered by time by instance and time
+func toLockSnapshots(samples []LockSampleDB) []*lockSnapshot {
+	snapshots := make([]*lockSnapshot, 0)
+	current := make(map[string]*lockSnapshot)
+	for _, sample := range samples {
+		snapshot, ok := current[sample.InstanceName]
+		if !ok || !snapshot.timestamp.Equal(sample.CurrentTimestamp) {
+			snapshot = newLockSnapshot(sample)
+			current[sample.InstanceName] = snapshot
+			snapshots = append(snapshots, snapshot)
+		}
+		snapshot.add(sample)
+	}
+
+	return snapshots
+}
+
+// toLockChains rebuilds the blocking chains from the samples ordered by time, the longest chains first.
+// A chain continues while its head blocks sessions in consecutive snapshots of its instance.
+func toLockChains(samples []LockSampleDB) []*proto.LockChain {
+	chains := make([]*lockChain, 0)
+	// open are the chains of the previous snapshot of each instance by head pid
+	open := make(map[string]map[uint32]*lockChain)
+	for _, snapshot := range toLockSnapshots(samples) {
+		previous := open[snapshot.instanceName]
+		current := make(map[uint32]*lockChain)
+		for _, head := range snapshot.heads() {
+			tree, blockedSessions := snapshot.tree(head, make(map[uint32]bool))
+
+			chain, ok := previous[head]
+			if !ok || snapshot.timestamp.After(chain.lastSeen.Add(chain.periodLength)) {
+				chain = &lockChain{firstSeen: snapshot.timestamp, instanceName: snapshot.instanceName}
+				chains = append(chains, chain)
+			}
+			chain.lastSeen = snapshot.timestamp
+			chain.periodLength = snapshot.periodLength
+			if blockedSessions > chain.maxBlockedSessions {
+				chain.maxBlockedSessions = blockedSessions
+				chain.head = tree
+			}
+			current[head] = chain
+		}
+		open[snapshot.instanceName] = current
+	}
+
+	protoChains := make([]*proto.LockChain, 0, len(chains))
+	for _, chain := range chains {
+		protoChains = append(protoChains, chain.toProto())
+	}
+	sort.SliceStable(protoChains, func(i, j int) bool {
+		return protoChains[i].DurationSecs > protoChains[j].DurationSecs
+	})
+
+	return protoChains
+}
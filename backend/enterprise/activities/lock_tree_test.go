@@ -0,0 +1,61 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestToLockChains(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	snapshot := func(offset time.Duration, samples ...LockSampleDB) []LockSampleDB {
+		for i := range samples {
+			samples[i].InstanceName = "primary"
+			samples[i].CurrentTimestamp = start.Add(offset)
+			samples[i].PeriodLength = 1
+		}
+		return samples
+	}
+
+	samples := make([]LockSampleDB, 0)
+	// 10 blocks 20 which blocks 30
+	samples = append(samples, snapshot(0,
+		LockSampleDB{Pid: 10, State: "idle in transaction", Query: "UPDATE accounts SET balance = 0"},
+		LockSampleDB{Pid: 20, BlockingPids: []uint32{10}, LockedRelation: "accounts", LockMode: "RowExclusiveLock"},
+	)...)
+	samples = append(samples, snapshot(time.Second,
+		LockSampleDB{Pid: 10, State: "idle in transaction", Query: "UPDATE accounts SET balance = 0"},
+		LockSampleDB{Pid: 20, BlockingPids: []uint32{10}, LockedRelation: "accounts", LockMode: "RowExclusiveLock"},
+		LockSampleDB{Pid: 30, BlockingPids: []uint32{20}, LockedRelation: "accounts", LockMode: "AccessExclusiveLock"},
+	)...)
+	// a deadlock has no head
+	samples = append(samples, snapshot(2*time.Second,
+		LockSampleDB{Pid: 40, BlockingPids: []uint32{50}},
+		LockSampleDB{Pid: 50, BlockingPids: []uint32{40}},
+	)...)
+	// 10 blocks again after a while, this is another chain
+	samples = append(samples, snapshot(10*time.Second,
+		LockSampleDB{Pid: 20, BlockingPids: []uint32{10}},
+	)...)
+
+	chains := toLockChains(samples)
+	assert.Len(t, chains, 2)
+
+	chain := chains[0]
+	assert.Equal(t, "primary", chain.InstanceName)
+	assert.Equal(t, start, chain.FirstSeen.AsTime())
+	assert.Equal(t, start.Add(time.Second), chain.LastSeen.AsTime())
+	assert.Equal(t, float32(2), chain.DurationSecs)
+	assert.Equal(t, uint32(2), chain.MaxBlockedSessions)
+	assert.Equal(t, uint32(10), chain.Head.Pid)
+	assert.Equal(t, "UPDATE accounts SET balance = 0", chain.Head.Query)
+	assert.Len(t, chain.Head.Blocked, 1)
+	assert.Equal(t, "RowExclusiveLock", chain.Head.Blocked[0].LockMode)
+	assert.Len(t, chain.Head.Blocked[0].Blocked, 1)
+	assert.Equal(t, uint32(30), chain.Head.Blocked[0].Blocked[0].Pid)
+
+	// the head blocker of the last chain was not sampled
+	assert.Equal(t, float32(1), chains[1].DurationSecs)
+	assert.Equal(t, uint32(10), chains[1].Head.Pid)
+	assert.Empty(t, chains[1].Head.Query)
+}
@@ -0,0 +1,103 @@
+package activities
+
+import (
+	"postgres-explain/proto"
+	"sort"
+	"strings"
+)
+
+const (
+	autovacuumBackendType = "autovacuum worker"
+	autovacuumPrefix      = "autovacuum: "
+	wraparoundSuffix      = " (to prevent wraparound)"
+)
+
+// autovacuumOperations are the operations reported by the autovacuum workers as their query, the longest first
+var autovacuumOperations = []string{"VACUUM ANALYZE", "BRIN summarize", "VACUUM", "ANALYZE"}
+
+// BackendTypeLoadDB is the load of a backend type
+type BackendTypeLoadDB struct {
+	BackendType string  `json:"backend_type"`
+	AAS         float64 `json:"aas"`
+}
+
+// MaintenanceLoadDB is the load of an autovacuum worker query by wait event
+type MaintenanceLoadDB struct {
+	Datname   string  `json:"datname"`
+	Query     string  `json:"query"`
+	WaitEvent string  `json:"wait_event"`
+	AAS       float64 `json:"aas"`
+}
+
+// parseAutovacuumQuery returns the operation and the relation of a query of an autovacuum worker,
+// such as "autovacuum: VACUUM ANALYZE public.accounts (to prevent wraparound)".
+func parseAutovacuumQuery(query string) (operation, relation string, wraparound bool, ok bool) {
+	if !strings.HasPrefix(query, autovacuumPrefix) {
+		return "", "", false, false
+	}
+
+	query = strings.TrimPrefix(query, autovacuumPrefix)
+	if strings.HasSuffix(query, wraparoundSuffix) {
+		query = strings.TrimSuffix(query, wraparoundSuffix)
+		wraparound = true
+	}
+
+	for _, operation := range autovacuumOperations {
+		if !strings.HasPrefix(query, operation+" ") {
+			continue
+		}
+
+		// BRIN summarize is followed by the block number
+		fields := strings.Fields(strings.TrimPrefix(query, operation+" "))
+		if len(fields) == 0 {
+			return "", "", false, false
+		}
+
+		return operation, fields[0], wraparound, true
+	}
+
+	return "", "", false, false
+}
+
+// toMaintenanceTables attributes the load of the autovacuum workers to the tables they processed, the busiest first.
+// Queries which cannot be parsed are kept with their query as operation.
+func toMaintenanceTables(loads []MaintenanceLoadDB) []*proto.MaintenanceTable {
+	type tableKey struct {
+		datname    string
+		relation   string
+		operation  string
+		wraparound bool
+	}
+
+	tables := make([]*proto.MaintenanceTable, 0)
+	tablesByKey := make(map[tableKey]*proto.MaintenanceTable)
+	for _, load := range loads {
+		operation, relation, wraparound, ok := parseAutovacuumQuery(load.Query)
+		if !ok {
+			operation = load.Query
+		}
+
+		key := tableKey{datname: load.Datname, relation: relation, operation: operation, wraparound: wraparound}
+		table, ok := tablesByKey[key]
+		if !ok {
+			table = &proto.MaintenanceTable{
+				Datname:             load.Datname,
+				Relation:            relation,
+				Operation:           operation,
+				ToPreventWraparound: wraparound,
+				AasWaitEvents:       make(map[string]float32),
+			}
+			tablesByKey[key] = table
+			tables = append(tables, table)
+		}
+
+		table.Aas += float32(load.AAS)
+		table.AasWaitEvents[load.WaitEvent] += float32(load.AAS)
+	}
+
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].Aas > tables[j].Aas
+	})
+
+	return tables
+}
@@ -0,0 +1,47 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseAutovacuumQuery(t *testing.T) {
+	tests := []struct {
+		query      string
+		operation  string
+		relation   string
+		wraparound bool
+		ok         bool
+	}{
+		{query: "autovacuum: VACUUM public.accounts", operation: "VACUUM", relation: "public.accounts", ok: true},
+		{query: "autovacuum: VACUUM ANALYZE public.accounts", operation: "VACUUM ANALYZE", relation: "public.accounts", ok: true},
+		{query: "autovacuum: ANALYZE public.accounts", operation: "ANALYZE", relation: "public.accounts", ok: true},
+		{query: "autovacuum: VACUUM pg_catalog.pg_class (to prevent wraparound)", operation: "VACUUM", relation: "pg_catalog.pg_class", wraparound: true, ok: true},
+		{query: "autovacuum: BRIN summarize public.events_brin 42", operation: "BRIN summarize", relation: "public.events_brin", ok: true},
+		{query: "SELECT 1"},
+		{query: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			operation, relation, wraparound, ok := parseAutovacuumQuery(tt.query)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.operation, operation)
+			assert.Equal(t, tt.relation, relation)
+			assert.Equal(t, tt.wraparound, wraparound)
+		})
+	}
+}
+
+func TestToMaintenanceTables(t *testing.T) {
+	tables := toMaintenanceTables([]MaintenanceLoadDB{
+		{Datname: "shop", Query: "autovacuum: VACUUM public.orders", WaitEvent: "CPU", AAS: 0.25},
+		{Datname: "shop", Query: "autovacuum: VACUUM public.accounts", WaitEvent: "CPU", AAS: 0.5},
+		{Datname: "shop", Query: "autovacuum: VACUUM public.accounts", WaitEvent: "DataFileRead", AAS: 0.25},
+	})
+
+	assert.Len(t, tables, 2)
+	assert.Equal(t, "public.accounts", tables[0].Relation)
+	assert.Equal(t, float32(0.75), tables[0].Aas)
+	assert.Equal(t, map[string]float32{"CPU": 0.5, "DataFileRead": 0.25}, tables[0].AasWaitEvents)
+	assert.Equal(t, "public.orders", tables[1].Relation)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/borealisdb/commons/credentials"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/enterprise/anomalies"
 	"postgres-explain/backend/enterprise/shared"
 	"postgres-explain/backend/modules"
 	"postgres-explain/proto"
@@ -17,6 +18,9 @@ type Module struct {
 	DB  *sqlx.DB
 
 	modules.Params
+
+	// stopped is closed once the queued samples are stored
+	stopped chan struct{}
 }
 
 func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider credentials.Credentials, params modules.Params) {
@@ -27,14 +31,44 @@ func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider cr
 }
 
 func (m *Module) Init(initArgs modules.InitArgs) error {
+	if m.DB.DriverName() != modules.ClickHouseDriver {
+		return fmt.Errorf("module %v requires %v storage", ModuleName, modules.ClickHouseDriver)
+	}
+
+	waitEvents, err := LoadWaitEventCatalog(m.WaitEventsMapFilePath)
+	if err != nil {
+		return fmt.Errorf("could not LoadWaitEventCatalog: %v", err)
+	}
+
+	repo := NewActivitiesRepository(m.DB, m.RetentionDays)
+
+	queuePolicy, err := ParseQueuePolicy(m.ActivitiesQueuePolicy)
+	if err != nil {
+		return err
+	}
+	activitySampler := NewActivitySampler(m.DB, m.ActivitiesQueueSize, queuePolicy, m.Log)
+	m.stopped = make(chan struct{})
+	go func() {
+		defer close(m.stopped)
+		activitySampler.Run(initArgs.Ctx)
+	}()
+
+	live := NewLiveBroker()
 	activitiesProfilerService := NewService(
-		NewActivitiesRepository(m.DB),
+		repo,
 		shared.NewMetricsRepository(m.DB),
-		LoadWaitEventsMapFromFile(m.WaitEventsMapFilePath),
+		anomalies.NewRepository(m.DB),
+		waitEvents,
+		initArgs.Cache,
+		live,
+		activitySampler,
 		m.Log,
 	)
 	activityCollectorService := &ActivityCollectorService{
-		ActivitySampler: NewActivitySampler(m.DB, m.Log),
+		ActivitySampler: activitySampler,
+		WaitEvents:      waitEvents,
+		Live:            live,
+		Deduplicator:    shared.NewBatchDeduplicator(shared.DeduplicationWindow, 0),
 		Log:             m.Log,
 	}
 	proto.RegisterActivityCollectorServer(initArgs.GrpcServer, activityCollectorService)
@@ -45,3 +79,21 @@ func (m *Module) Init(initArgs modules.InitArgs) error {
 
 	return nil
 }
+
+// Wait waits for the activity sampler to store the queued samples
+func (m *Module) Wait() {
+	if m.stopped != nil {
+		<-m.stopped
+	}
+}
+
+func (m *Module) Tables() []modules.Table {
+	return []modules.Table{{
+		Name:      ActivitiesTableName,
+		SelectSQL: exportActivitySQL,
+		InsertSQL: insertActivitySQL,
+		NewRow: func() interface{} {
+			return &ActivitySampleDB{}
+		},
+	}}
+}
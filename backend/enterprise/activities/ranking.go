@@ -0,0 +1,137 @@
+package activities
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	rankByLoad           = "load"
+	rankByWaitEvent      = "wait_event"
+	rankByWaitEventClass = "wait_event_class"
+	rankByAvgDuration    = "avg_duration"
+	rankBySessions       = "sessions"
+	rankByMetric         = "metric"
+
+	// defaultTopQueriesLimit is the number of top queries returned when no limit is requested
+	defaultTopQueriesLimit = 25
+	// maxTopQueriesLimit bounds the number of top queries of a request
+	maxTopQueriesLimit = 500
+)
+
+// rankingMetrics are the columns of the analytics the queries can be ranked by
+var rankingMetrics = map[string]bool{
+	"num_queries":                 true,
+	"m_query_time_sum":            true,
+	"m_rows_sent_sum":             true,
+	"m_shared_blks_hit_sum":       true,
+	"m_shared_blks_read_sum":      true,
+	"m_shared_blks_dirtied_sum":   true,
+	"m_shared_blks_written_sum":   true,
+	"m_temp_blks_read_sum":        true,
+	"m_temp_blks_written_sum":     true,
+	"m_blk_read_time_sum":         true,
+	"m_blk_write_time_sum":        true,
+	"m_cpu_user_time_sum":         true,
+	"m_cpu_sys_time_sum":          true,
+	"m_plans_calls_sum":           true,
+	"m_plan_time_sum":             true,
+	"m_wal_records_sum":           true,
+	"m_wal_fpi_sum":               true,
+	"m_wal_bytes_sum":             true,
+	"m_jit_functions_sum":         true,
+	"m_jit_generation_time_sum":   true,
+	"m_jit_inlining_time_sum":     true,
+	"m_jit_optimization_time_sum": true,
+	"m_jit_emission_time_sum":     true,
+}
+
+// Ranking is the key the top queries are ordered by
+type Ranking struct {
+	Key string
+	// WaitEvents are the wait events whose load ranks the queries, for the wait_event and wait_event_class keys
+	WaitEvents []string
+	// Metric is the column of the analytics ranking the queries, for the metric key
+	Metric string
+}
+
+// rankingSQL is the rank_value of a query computed from the aggregates of grouping,
+// the statement metrics are joined by rankingJoinSQL
+const rankingSQL = `{{ if eq .Ranking.Key "wait_event" "wait_event_class" }}sumIf(cpu_load_by_wait_event, wait_event IN (:rank_wait_events)){{ else if eq .Ranking.Key "avg_duration" }}sum(duration_sum) / sum(samples){{ else if eq .Ranking.Key "sessions" }}uniqExactMerge(sessions_state){{ else if eq .Ranking.Key "metric" }}any(am.metric_value){{ else }}sum(cpu_load_by_wait_event){{ end }} AS rank_value`
+
+const rankingJoinSQL = `{{ if eq .Ranking.Key "metric" }}
+                        LEFT JOIN (SELECT fingerprint, sum({{ .Ranking.Metric }}) AS metric_value
+                                   FROM analytics
+                                   WHERE period_start > :period_start_from
+                                     AND period_start < :period_start_to
+                                     AND organization = :organization
+                                     AND cluster_name = :cluster_name
+                                   GROUP BY fingerprint) am ON grouping.fingerprint = am.fingerprint{{ end }}`
+
+// parseRanking parses the rank_by of a request: load, avg_duration, sessions, wait_event:<name>,
+// wait_event_class:<class> or metric:<column>. The queries are ranked by load when it is empty.
+func parseRanking(rankBy string, waitEvents map[string]WaitEvent) (Ranking, error) {
+	key, value, _ := strings.Cut(rankBy, ":")
+
+	switch key {
+	case "", rankByLoad:
+		return Ranking{Key: rankByLoad}, nil
+	case rankByAvgDuration, rankBySessions:
+		return Ranking{Key: key}, nil
+	case rankByWaitEvent:
+		if value == "" {
+			return Ranking{}, fmt.Errorf("rank_by %v is missing the wait event", rankBy)
+		}
+
+		return Ranking{Key: key, WaitEvents: []string{value}}, nil
+	case rankByWaitEventClass:
+		if _, ok := waitEventClasses[value]; !ok {
+			return Ranking{}, fmt.Errorf("wait event class %v is not valid", value)
+		}
+
+		ranking := Ranking{Key: key}
+		for name, waitEvent := range waitEvents {
+			if waitEvent.Class == value {
+				ranking.WaitEvents = append(ranking.WaitEvents, name)
+			}
+		}
+		if len(ranking.WaitEvents) == 0 {
+			return Ranking{}, fmt.Errorf("wait event class %v has no wait events", value)
+		}
+		sort.Strings(ranking.WaitEvents)
+
+		return ranking, nil
+	case rankByMetric:
+		if !rankingMetrics[value] {
+			return Ranking{}, fmt.Errorf("metric %v cannot rank the queries", value)
+		}
+
+		return Ranking{Key: key, Metric: value}, nil
+	default:
+		return Ranking{}, fmt.Errorf("rank_by %v is not valid, must be one of: %v, %v:<name>, %v:<class>, %v, %v, %v:<column>",
+			rankBy, rankByLoad, rankByWaitEvent, rankByWaitEventClass, rankByAvgDuration, rankBySessions, rankByMetric)
+	}
+}
+
+// getRequestPage returns the limit and the offset of the top queries of a request
+func getRequestPage(limit, offset uint32) (uint32, uint32, error) {
+	if limit == 0 {
+		limit = defaultTopQueriesLimit
+	}
+	if limit > maxTopQueriesLimit {
+		return 0, 0, fmt.Errorf("limit %d is above the maximum of %d", limit, maxTopQueriesLimit)
+	}
+
+	return limit, offset, nil
+}
+
+// toRankValues returns the value of the ranking key of each query
+func toRankValues(queries []QueryDB, xValueGetter func(db QueryDB) string) map[string]float32 {
+	rankValues := make(map[string]float32, len(queries))
+	for _, query := range queries {
+		rankValues[xValueGetter(query)] = float32(query.RankValue)
+	}
+
+	return rankValues
+}
@@ -0,0 +1,103 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"postgres-explain/backend/enterprise/shared"
+	"strings"
+	"testing"
+)
+
+func TestParseRanking(t *testing.T) {
+	waitEvents := map[string]WaitEvent{
+		"DataFileRead":  {Type: "IO", Class: "IO"},
+		"WALWrite":      {Type: "IO", Class: "IO"},
+		"transactionid": {Type: "Lock", Class: "Lock"},
+	}
+
+	tests := []struct {
+		name    string
+		rankBy  string
+		want    Ranking
+		wantErr bool
+	}{
+		{name: "default", rankBy: "", want: Ranking{Key: rankByLoad}},
+		{name: "load", rankBy: "load", want: Ranking{Key: rankByLoad}},
+		{name: "avg duration", rankBy: "avg_duration", want: Ranking{Key: rankByAvgDuration}},
+		{name: "sessions", rankBy: "sessions", want: Ranking{Key: rankBySessions}},
+		{name: "wait event", rankBy: "wait_event:transactionid", want: Ranking{Key: rankByWaitEvent, WaitEvents: []string{"transactionid"}}},
+		{name: "wait event class", rankBy: "wait_event_class:IO", want: Ranking{Key: rankByWaitEventClass, WaitEvents: []string{"DataFileRead", "WALWrite"}}},
+		{name: "metric", rankBy: "metric:m_shared_blks_read_sum", want: Ranking{Key: rankByMetric, Metric: "m_shared_blks_read_sum"}},
+		{name: "missing wait event", rankBy: "wait_event", wantErr: true},
+		{name: "unknown wait event class", rankBy: "wait_event_class:disk", wantErr: true},
+		{name: "metric not allowed", rankBy: "metric:queryid", wantErr: true},
+		{name: "invalid", rankBy: "cost", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranking, err := parseRanking(tt.rankBy, waitEvents)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ranking)
+		})
+	}
+}
+
+func TestGetRequestPage(t *testing.T) {
+	limit, offset, err := getRequestPage(0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(defaultTopQueriesLimit), limit)
+	assert.Equal(t, uint32(0), offset)
+
+	limit, offset, err = getRequestPage(50, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(50), limit)
+	assert.Equal(t, uint32(100), offset)
+
+	_, _, err = getRequestPage(maxTopQueriesLimit+1, 0)
+	assert.Error(t, err)
+}
+
+func TestTopQueriesSQLTemplateRanking(t *testing.T) {
+	tests := []struct {
+		name       string
+		ranking    Ranking
+		expression string
+	}{
+		{name: "load", ranking: Ranking{Key: rankByLoad}, expression: "sum(cpu_load_by_wait_event) AS rank_value"},
+		{name: "wait events", ranking: Ranking{Key: rankByWaitEventClass, WaitEvents: []string{"DataFileRead", "WALWrite"}}, expression: "sumIf(cpu_load_by_wait_event, wait_event IN (?, ?)) AS rank_value"},
+		{name: "avg duration", ranking: Ranking{Key: rankByAvgDuration}, expression: "sum(duration_sum) / sum(samples) AS rank_value"},
+		{name: "sessions", ranking: Ranking{Key: rankBySessions}, expression: "uniqExactMerge(sessions_state) AS rank_value"},
+		{name: "metric", ranking: Ranking{Key: rankByMetric, Metric: "m_shared_blks_read_sum"}, expression: "any(am.metric_value) AS rank_value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queryArgs := map[string]interface{}{
+				"period_start_from": 1,
+				"period_start_to":   2,
+				"period_duration":   1,
+				"organization":      "default",
+				"cluster_name":      "cluster",
+				"limit":             25,
+				"offset":            50,
+				"rank_wait_events":  tt.ranking.WaitEvents,
+			}
+			tmplArgs := struct {
+				GroupBy string
+				Filters []DimensionFilter
+				Ranking Ranking
+			}{
+				Ranking: tt.ranking,
+			}
+
+			query, args, err := shared.ProcessQueryWithTemplate(tmplArgs, queryArgs, topQueriesSQLTemplate)
+			assert.NoError(t, err)
+			assert.Contains(t, query, tt.expression)
+			assert.Contains(t, query, "LIMIT ? OFFSET ?")
+			assert.Equal(t, tt.ranking.Key == rankByMetric, strings.Contains(query, "sum(m_shared_blks_read_sum) AS metric_value"))
+			assert.Equal(t, strings.Count(query, "?"), len(args))
+		})
+	}
+}
@@ -2,22 +2,48 @@ package activities
 
 import (
 	"context"
-	"fmt"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"postgres-explain/backend/enterprise/shared"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
 )
 
 type ActivityCollectorService struct {
 	proto.ActivityCollectorServer
 	ActivitySampler *ActivitySampler
-	Log             *logrus.Entry
+	WaitEvents      *WaitEventCatalog
+	Live            *LiveBroker
+	// Deduplicator skips the requests retried by the collectors
+	Deduplicator *shared.BatchDeduplicator
+	Log          *logrus.Entry
 }
 
 func (s ActivityCollectorService) Collect(ctx context.Context, request *proto.ActivityCollectRequest) (*proto.ActivityCollectResponse, error) {
 	s.Log.Infof("Received %+v activity samples", len(request.ActivitySamples))
 
-	if err := s.ActivitySampler.Save(request); err != nil {
-		return nil, fmt.Errorf("could not Save: %v", err)
+	org := organization.FromContext(ctx)
+	switch s.Deduplicator.Claim(org, request.BatchId) {
+	case shared.BatchStored:
+		s.Log.Infof("Skipped batch %v (sequence %v), it was already stored", request.BatchId, request.SequenceNumber)
+		return &proto.ActivityCollectResponse{}, nil
+	case shared.BatchPending:
+		// the first request of the batch may still fail, the collector retries until its result is known
+		return nil, status.Errorf(codes.Aborted, "batch %v is being stored, retry later", request.BatchId)
+	}
+
+	// the errors of Save carry the status returned to the collector, such as ResourceExhausted when the queue is full
+	if err := s.ActivitySampler.Save(ctx, org, request); err != nil {
+		s.Deduplicator.Release(org, request.BatchId)
+		return nil, err
+	}
+	s.Deduplicator.Stored(org, request.BatchId)
+
+	s.Live.Publish(org, request.ActivitySamples)
+
+	if registered := s.WaitEvents.RegisterSamples(org, request.ActivitySamples); len(registered) > 0 {
+		s.Log.Infof("Registered wait events missing from the catalogs: %v", registered)
 	}
 
 	return &proto.ActivityCollectResponse{}, nil
@@ -1,10 +1,18 @@
 package activities
 
 import (
+	"context"
+	"fmt"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"postgres-explain/backend/enterprise/shared"
 	"postgres-explain/proto"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const ActivitiesTableName = "activities"
@@ -12,6 +20,7 @@ const ActivitiesTableName = "activities"
 const insertActivitySQL = `
   INSERT INTO activities
   (
+    organization,
     current_timestamp,
     period_start,
     period_length,
@@ -19,6 +28,9 @@ const insertActivitySQL = `
 	query_id,
     datname,
     pid,
+    blocking_pids,
+    locked_relation,
+    lock_mode,
 	usesysid,
     usename,
     application_name,
@@ -30,15 +42,21 @@ const insertActivitySQL = `
     query,
     state,
     query_start,
+    xact_start,
+    backend_xmin,
+    state_change,
+    backend_start,
     duration,
 	cluster_name,
     instance_name,
     cpu_cores,
 	is_query_truncated,
 	query_sha,
-	is_not_explainable
+	is_not_explainable,
+    batch_id
    )
   VALUES (
+    :organization,
     :current_timestamp,
 	:period_start,
     :period_length,
@@ -46,6 +64,9 @@ const insertActivitySQL = `
    	:query_id,
     :datname,
     :pid,
+    :blocking_pids,
+    :locked_relation,
+    :lock_mode,
     :usesysid,
     :usename,
 	:application_name,
@@ -57,43 +78,396 @@ const insertActivitySQL = `
     :query,
     :state,
 	:query_start,
-    :duration
+    :xact_start,
+    :backend_xmin,
+    :state_change,
+    :backend_start,
+    :duration,
 	:cluster_name,
     :instance_name,
     :cpu_cores,
-	:is_query_truncated
+	:is_query_truncated,
 	:query_sha,
-	:is_not_explainable
+	:is_not_explainable,
+    :batch_id
   )
 `
 
+// exportActivitySQL selects the samples of a cluster with the columns named after the parameters of insertActivitySQL
+const exportActivitySQL = `
+SELECT organization,
+       "current_timestamp",
+       period_start,
+       period_length,
+       fingerprint,
+       query_id,
+       datname,
+       pid,
+       blocking_pids,
+       locked_relation,
+       lock_mode,
+       usesysid,
+       usename,
+       application_name,
+       backend_type,
+       client_hostname,
+       wait_event_type,
+       wait_event,
+       parsed_query,
+       query,
+       state,
+       query_start,
+       xact_start,
+       backend_xmin,
+       state_change,
+       backend_start,
+       duration,
+       cluster_name,
+       instance_name,
+       cpu_cores,
+       is_query_truncated,
+       query_sha,
+       is_not_explainable
+FROM activities
+WHERE organization = :organization
+  AND cluster_name = :cluster_name
+  AND period_start >= :period_start_from
+  AND period_start <= :period_start_to
+ORDER BY period_start`
+
+const (
+	// requestsCap is the default number of requests waiting to be inserted
+	requestsCap = 100
+	// maxBatchSamples flushes a batch before batchTimeout once it holds that many samples
+	maxBatchSamples = 10000
+	batchTimeout    = 500 * time.Millisecond
+	batchErrorDelay = time.Second
+)
+
+// QueuePolicy is what Save does when the queue of the requests is full
+type QueuePolicy string
+
+const (
+	// QueuePolicyBlock waits for room in the queue until the request of the collector is canceled
+	QueuePolicyBlock QueuePolicy = "block"
+	// QueuePolicyDropOldest drops the oldest requests of the queue to make room for the new one
+	QueuePolicyDropOldest QueuePolicy = "drop-oldest"
+	// QueuePolicyReject rejects the request with ResourceExhausted, the collector can retry later
+	QueuePolicyReject QueuePolicy = "reject"
+)
+
+// ParseQueuePolicy validates a policy, the default one is QueuePolicyBlock
+func ParseQueuePolicy(policy string) (QueuePolicy, error) {
+	switch QueuePolicy(policy) {
+	case "":
+		return QueuePolicyBlock, nil
+	case QueuePolicyBlock, QueuePolicyDropOldest, QueuePolicyReject:
+		return QueuePolicy(policy), nil
+	default:
+		return "", fmt.Errorf("queue policy %v is not valid, must be one of: %v, %v, %v", policy, QueuePolicyBlock, QueuePolicyDropOldest, QueuePolicyReject)
+	}
+}
+
+// collectRequest is a request of a collector together with the organization it belongs to
+type collectRequest struct {
+	organization string
+	batchID      string
+	samples      []*proto.ActivitySample
+}
+
+// ActivitySamplerStats are the counters of the samples of an organization which were not stored and the state of the queue
+type ActivitySamplerStats struct {
+	// DroppedSamples were removed from the queue or never queued because of the queue policy
+	DroppedSamples uint64
+	// FailedSamples could not be inserted
+	FailedSamples uint64
+	// QueuedRequests of the organization are waiting in the queue of QueueSize requests or being inserted
+	QueuedRequests int
+	QueueSize      int
+	QueuePolicy    QueuePolicy
+}
+
+// organizationCounters are the counters of the requests of an organization
+type organizationCounters struct {
+	dropped uint64
+	failed  uint64
+	queued  int
+}
+
+// ActivitySampler queues the samples received from the collectors and inserts them by batches,
+// like analytics.MetricsBucket
 type ActivitySampler struct {
-	db *sqlx.DB
-	l  *logrus.Entry
+	db     *sqlx.DB
+	l      *logrus.Entry
+	policy QueuePolicy
+
+	requestsCh chan collectRequest
+	// mu is held by Save while it queues a request, so that Run drains the queue once no request is being queued
+	mu sync.RWMutex
+	// done is closed when Run stops, Save rejects the requests from then on
+	done chan struct{}
+
+	// droppedSamples and failedSamples count the samples of all the organizations, for the logs
+	droppedSamples atomic.Uint64
+	failedSamples  atomic.Uint64
+
+	countersMu sync.Mutex
+	counters   map[string]*organizationCounters
+}
+
+func NewActivitySampler(db *sqlx.DB, queueSize int, policy QueuePolicy, log *logrus.Entry) *ActivitySampler {
+	if queueSize <= 0 {
+		queueSize = requestsCap
+	}
+
+	return &ActivitySampler{
+		db:         db,
+		l:          log.WithField("subcomponent", "activity-collector"),
+		policy:     policy,
+		requestsCh: make(chan collectRequest, queueSize),
+		done:       make(chan struct{}),
+		counters:   map[string]*organizationCounters{},
+	}
 }
 
-func NewActivitySampler(db *sqlx.DB, log *logrus.Entry) *ActivitySampler {
-	return &ActivitySampler{db: db, l: log.WithField("subcomponent", "activity-collector")}
+// Stats returns the counters of the samples of the organization which were not stored since the start
+func (as *ActivitySampler) Stats(organization string) ActivitySamplerStats {
+	stats := ActivitySamplerStats{
+		QueueSize:   cap(as.requestsCh),
+		QueuePolicy: as.policy,
+	}
+
+	as.countersMu.Lock()
+	defer as.countersMu.Unlock()
+	if counters, ok := as.counters[organization]; ok {
+		stats.DroppedSamples = counters.dropped
+		stats.FailedSamples = counters.failed
+		stats.QueuedRequests = counters.queued
+	}
+
+	return stats
 }
 
-// Save store metrics bucket received from agent into db.
-func (as *ActivitySampler) Save(agentMsg *proto.ActivityCollectRequest) error {
+// count updates the counters of the organization of the request
+func (as *ActivitySampler) count(req collectRequest, update func(counters *organizationCounters)) {
+	as.countersMu.Lock()
+	defer as.countersMu.Unlock()
+
+	counters, ok := as.counters[req.organization]
+	if !ok {
+		counters = &organizationCounters{}
+		as.counters[req.organization] = counters
+	}
+	update(counters)
+}
+
+// queued counts a request added to the queue
+func (as *ActivitySampler) queued(req collectRequest) {
+	as.count(req, func(counters *organizationCounters) { counters.queued++ })
+}
+
+// dropped counts the samples of a request which will not be stored, dequeued tells if the request was in the queue
+func (as *ActivitySampler) dropped(req collectRequest, dequeued bool) {
+	as.droppedSamples.Add(uint64(len(req.samples)))
+	as.count(req, func(counters *organizationCounters) {
+		counters.dropped += uint64(len(req.samples))
+		if dequeued {
+			counters.queued--
+		}
+	})
+}
+
+// inserted counts a request taken from the queue, its samples are counted as failed when it could not be inserted
+func (as *ActivitySampler) inserted(req collectRequest, failed bool) {
+	if failed {
+		as.failedSamples.Add(uint64(len(req.samples)))
+	}
+	as.count(req, func(counters *organizationCounters) {
+		if failed {
+			counters.failed += uint64(len(req.samples))
+		}
+		counters.queued--
+	})
+}
+
+// Run stores incoming data until context is canceled.
+// It exits when the requests queued before are stored.
+func (as *ActivitySampler) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := as.insertBatch(as.nextBatch(ctx.Done(), batchTimeout)); err != nil {
+			as.l.Errorf("could not insert batch: %v", err)
+			time.Sleep(batchErrorDelay)
+		}
+	}
+
+	as.l.Warn("Draining requests queue.")
+	close(as.done)
+	// wait for the requests being queued
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	for len(as.requestsCh) > 0 {
+		if err := as.insertBatch(as.nextBatch(nil, 0)); err != nil {
+			as.l.Errorf("could not insert batch: %v", err)
+			break
+		}
+	}
+
+	for len(as.requestsCh) > 0 {
+		as.dropped(<-as.requestsCh, true)
+	}
+	as.l.Infof("Requests queue drained, %v samples dropped and %v failed since the start", as.droppedSamples.Load(), as.failedSamples.Load())
+}
+
+// nextBatch waits for a first request then adds the next ones until the batch holds maxBatchSamples samples,
+// the timeout expires or the queue is empty when there is no timeout
+func (as *ActivitySampler) nextBatch(stop <-chan struct{}, timeout time.Duration) []collectRequest {
+	batch := make([]collectRequest, 0)
+
+	// wait for first request before doing anything, ignore timeout
+	var req collectRequest
+	select {
+	case req = <-as.requestsCh:
+	case <-stop:
+		return batch
+	default:
+		if timeout == 0 {
+			return batch
+		}
+		select {
+		case req = <-as.requestsCh:
+		case <-stop:
+			return batch
+		}
+	}
+
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timeoutCh = t.C
+	}
+
+	samples := 0
+	for {
+		batch = append(batch, req)
+		samples += len(req.samples)
+		if samples >= maxBatchSamples {
+			return batch
+		}
+
+		// wait for the next request or exit on timer
+		select {
+		case req = <-as.requestsCh:
+			continue
+		default:
+		}
+		if timeout == 0 {
+			return batch
+		}
+		select {
+		case req = <-as.requestsCh:
+		case <-timeoutCh:
+			return batch
+		case <-stop:
+			return batch
+		}
+	}
+}
+
+// Save queues the samples received from agent, they are stored by Run. A full queue is handled by the policy of the sampler.
+func (as *ActivitySampler) Save(ctx context.Context, organization string, agentMsg *proto.ActivityCollectRequest) error {
 	if len(agentMsg.ActivitySamples) == 0 {
-		as.l.Warnf("Nothing to save - no metrics buckets.")
+		as.l.Warnf("Nothing to save - no activity samples.")
+		return nil
+	}
+
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+
+	req := collectRequest{organization: organization, batchID: agentMsg.BatchId, samples: agentMsg.ActivitySamples}
+	select {
+	case <-as.done:
+		as.dropped(req, false)
+		return status.Error(codes.Unavailable, "the activity samples are not stored anymore, the server is shutting down")
+	default:
+	}
+
+	// the request is counted before it is sent so that the counter never goes below zero when it is inserted at once
+	as.queued(req)
+	select {
+	case as.requestsCh <- req:
+		return nil
+	default:
+	}
+
+	switch as.policy {
+	case QueuePolicyDropOldest:
+		for {
+			select {
+			case as.requestsCh <- req:
+				return nil
+			default:
+			}
+			select {
+			case oldest := <-as.requestsCh:
+				as.dropped(oldest, true)
+				as.l.Warnf("Queue is full, dropped %v samples", len(oldest.samples))
+			default:
+			}
+		}
+	case QueuePolicyReject:
+		as.dropped(req, true)
+		return status.Errorf(codes.ResourceExhausted, "the queue of the activity samples is full, %v samples were rejected", len(req.samples))
+	default:
+		select {
+		case as.requestsCh <- req:
+			return nil
+		case <-as.done:
+			as.dropped(req, true)
+			return status.Error(codes.Unavailable, "the activity samples are not stored anymore, the server is shutting down")
+		case <-ctx.Done():
+			as.dropped(req, true)
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// insertBatch inserts the requests of a batch, each one with its own INSERT so that ClickHouse deduplicates
+// the retried requests, the samples of the requests which could not be inserted are counted as failed
+func (as *ActivitySampler) insertBatch(batch []collectRequest) (err error) {
+	if len(batch) == 0 {
 		return nil
 	}
 
-	if err := as.insertBatch(agentMsg.ActivitySamples); err != nil {
+	var samples, failed int
+	start := time.Now()
+	for _, req := range batch {
+		e := as.insertRequest(req)
+		as.inserted(req, e != nil)
+		if e != nil {
+			failed += len(req.samples)
+			err = e
+			continue
+		}
+		samples += len(req.samples)
+	}
+
+	if err != nil {
+		as.l.Errorf("Failed to save %v samples in %s, %v samples dropped and %v failed since the start", failed, time.Since(start), as.droppedSamples.Load(), as.failedSamples.Load())
 		return err
 	}
 
+	as.l.Infof("Saved %v samples in %s", samples, time.Since(start))
 	return nil
 }
 
-func (as *ActivitySampler) insertBatch(samples []*proto.ActivitySample) (err error) {
+func (as *ActivitySampler) insertRequest(req collectRequest) (err error) {
+	ctx := shared.DeduplicationContext(context.Background(), req.organization, req.batchID)
+
 	// begin "transaction" and commit or rollback it on exit
 	var tx *sqlx.Tx
-	if tx, err = as.db.Beginx(); err != nil {
+	if tx, err = as.db.BeginTxx(ctx, nil); err != nil {
 		err = errors.Wrap(err, "failed to begin transaction")
 		return
 	}
@@ -109,7 +483,7 @@ func (as *ActivitySampler) insertBatch(samples []*proto.ActivitySample) (err err
 
 	// prepare INSERT statement and close it on exit
 	var stmt *sqlx.NamedStmt
-	if stmt, err = tx.PrepareNamed(insertActivitySQL); err != nil {
+	if stmt, err = tx.PrepareNamedContext(ctx, insertActivitySQL); err != nil {
 		err = errors.Wrap(err, "failed to prepare statement")
 		return
 	}
@@ -119,18 +493,16 @@ func (as *ActivitySampler) insertBatch(samples []*proto.ActivitySample) (err err
 		}
 	}()
 
-	savedSamplesCounter := 0
-	for _, sample := range samples {
+	for _, sample := range req.samples {
 		q := ActivitySampleDB{}
-		q.FromActivitySample(sample)
+		q.FromActivitySample(req.organization, sample)
+		q.BatchID = req.batchID
 
 		if _, err = stmt.Exec(q); err != nil {
 			err = errors.Wrap(err, "failed to exec")
 			return
 		}
-		savedSamplesCounter++
 	}
 
-	as.l.Infof("Saved %v samples", savedSamplesCounter)
 	return nil
 }
@@ -0,0 +1,214 @@
+package activities
+
+import (
+	"context"
+	_ "github.com/ClickHouse/clickhouse-go/v2" // register database/sql driver
+	"github.com/golang-migrate/migrate"
+	_ "github.com/golang-migrate/migrate/database/clickhouse" // register golang-migrate driver
+	_ "github.com/golang-migrate/migrate/source/file"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"postgres-explain/proto"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestSampler(queueSize int, policy QueuePolicy) *ActivitySampler {
+	return NewActivitySampler(nil, queueSize, policy, &logrus.Entry{Logger: logrus.New()})
+}
+
+func collectRequestOf(samples int) *proto.ActivityCollectRequest {
+	request := &proto.ActivityCollectRequest{}
+	for i := 0; i < samples; i++ {
+		request.ActivitySamples = append(request.ActivitySamples, &proto.ActivitySample{Pid: uint32(i)})
+	}
+
+	return request
+}
+
+// TestInsertActivitySQL checks that every column of insertActivitySQL has its parameter,
+// a missing comma merges two columns or two parameters into one item
+func TestInsertActivitySQL(t *testing.T) {
+	columnsSQL, valuesSQL, ok := strings.Cut(insertActivitySQL, "VALUES")
+	assert.True(t, ok)
+
+	list := func(sql string) []string {
+		sql = sql[strings.Index(sql, "(")+1 : strings.LastIndex(sql, ")")]
+		items := strings.Split(sql, ",")
+		for i, item := range items {
+			items[i] = strings.TrimSpace(item)
+		}
+		return items
+	}
+	columns, values := list(columnsSQL), list(valuesSQL)
+
+	assert.Equal(t, len(columns), len(values))
+	for i, column := range columns {
+		assert.Regexp(t, `^\w+$`, column)
+		assert.Equal(t, ":"+column, values[i])
+	}
+}
+
+func TestParseQueuePolicy(t *testing.T) {
+	policy, err := ParseQueuePolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, QueuePolicyBlock, policy)
+
+	policy, err = ParseQueuePolicy("drop-oldest")
+	assert.NoError(t, err)
+	assert.Equal(t, QueuePolicyDropOldest, policy)
+
+	_, err = ParseQueuePolicy("drop-newest")
+	assert.Error(t, err)
+}
+
+func TestActivitySampler_Save(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drop oldest", func(t *testing.T) {
+		sampler := newTestSampler(2, QueuePolicyDropOldest)
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(2)))
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(3)))
+
+		assert.Equal(t, ActivitySamplerStats{DroppedSamples: 1, QueuedRequests: 2, QueueSize: 2, QueuePolicy: QueuePolicyDropOldest}, sampler.Stats("org"))
+		assert.Len(t, (<-sampler.requestsCh).samples, 2)
+		assert.Len(t, (<-sampler.requestsCh).samples, 3)
+	})
+
+	t.Run("drop oldest of another organization", func(t *testing.T) {
+		sampler := newTestSampler(1, QueuePolicyDropOldest)
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+		assert.NoError(t, sampler.Save(ctx, "other", collectRequestOf(2)))
+
+		assert.Equal(t, ActivitySamplerStats{DroppedSamples: 1, QueueSize: 1, QueuePolicy: QueuePolicyDropOldest}, sampler.Stats("org"))
+		assert.Equal(t, ActivitySamplerStats{QueuedRequests: 1, QueueSize: 1, QueuePolicy: QueuePolicyDropOldest}, sampler.Stats("other"))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		sampler := newTestSampler(1, QueuePolicyReject)
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+
+		err := sampler.Save(ctx, "org", collectRequestOf(2))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, uint64(2), sampler.Stats("org").DroppedSamples)
+	})
+
+	t.Run("block until the request is canceled", func(t *testing.T) {
+		sampler := newTestSampler(1, QueuePolicyBlock)
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		err := sampler.Save(timeoutCtx, "org", collectRequestOf(2))
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Equal(t, uint64(2), sampler.Stats("org").DroppedSamples)
+	})
+
+	t.Run("empty request", func(t *testing.T) {
+		sampler := newTestSampler(1, QueuePolicyReject)
+		assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(0)))
+		assert.Empty(t, sampler.requestsCh)
+	})
+}
+
+func TestActivitySampler_Run(t *testing.T) {
+	sampler := newTestSampler(10, QueuePolicyBlock)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the requests saved after the shutdown are rejected
+	sampler.Run(ctx)
+
+	err := sampler.Save(context.Background(), "org", collectRequestOf(1))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, uint64(1), sampler.Stats("org").DroppedSamples)
+}
+
+func TestActivitySampler_NextBatch(t *testing.T) {
+	sampler := newTestSampler(10, QueuePolicyBlock)
+	ctx := context.Background()
+
+	assert.Empty(t, sampler.nextBatch(nil, 0))
+
+	// the batch is flushed once it holds maxBatchSamples samples
+	assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(maxBatchSamples-1)))
+	assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+	assert.NoError(t, sampler.Save(ctx, "org", collectRequestOf(1)))
+	assert.Len(t, sampler.nextBatch(nil, time.Minute), 2)
+
+	// or once the timeout expires
+	start := time.Now()
+	assert.Len(t, sampler.nextBatch(nil, 10*time.Millisecond), 1)
+	assert.Less(t, time.Since(start), time.Minute)
+
+	// the wait for a first request ends when stopped
+	stop := make(chan struct{})
+	close(stop)
+	assert.Empty(t, sampler.nextBatch(stop, time.Minute))
+}
+
+// TestActivitySampler_insertBatchDeduplication inserts a request twice and checks that its samples are stored once,
+// in the raw table and in the rollups. It needs an empty ClickHouse database, set CLICKHOUSE_TEST_DSN to run it.
+func TestActivitySampler_insertBatchDeduplication(t *testing.T) {
+	dsn := os.Getenv("CLICKHOUSE_TEST_DSN")
+	if dsn == "" {
+		t.Skip("CLICKHOUSE_TEST_DSN is not set")
+	}
+
+	m, err := migrate.New("file://../../migrations", dsn+"?x-multi-statement=true")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer m.Close()
+	if err := m.Up(); err != migrate.ErrNoChange && !assert.NoError(t, err) {
+		return
+	}
+
+	db, err := sqlx.Connect("clickhouse", dsn)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer db.Close()
+	db.Mapper = reflectx.NewMapperTagFunc("json", strings.ToUpper, func(value string) string {
+		return strings.Split(value, ",")[0]
+	})
+
+	organization := "dedup-" + time.Now().Format("20060102150405.000000")
+	periodStart := uint32(time.Now().Unix())
+	samples := make([]*proto.ActivitySample, 0)
+	for i := 0; i < 3; i++ {
+		samples = append(samples, &proto.ActivitySample{
+			Pid:                 uint32(i),
+			ClusterName:         "cluster",
+			PeriodStartUnixSecs: periodStart,
+			PeriodLengthSecs:    1,
+			CurrentTimestamp:    periodStart,
+			WaitEvent:           "CPU",
+			Fingerprint:         "fingerprint",
+		})
+	}
+	request := collectRequest{organization: organization, batchID: "batch-1", samples: samples}
+
+	sampler := NewActivitySampler(db, 0, QueuePolicyBlock, &logrus.Entry{Logger: logrus.New()})
+	// the collector retried the request, it was stored the first time
+	assert.NoError(t, sampler.insertBatch([]collectRequest{request}))
+	assert.NoError(t, sampler.insertBatch([]collectRequest{request}))
+
+	for table, query := range map[string]string{
+		"activities":                 `SELECT count() FROM activities WHERE organization = ?`,
+		"activities_1m":              `SELECT sum(wait_event_count) FROM activities_1m WHERE organization = ?`,
+		"activities_1h":              `SELECT sum(db_time_secs) FROM activities_1h WHERE organization = ?`,
+		"activities_fingerprints_1h": `SELECT sum(db_time_secs) FROM activities_fingerprints_1h WHERE organization = ?`,
+	} {
+		var stored uint64
+		assert.NoError(t, db.Get(&stored, query, organization), table)
+		assert.Equal(t, uint64(len(samples)), stored, table)
+	}
+}
@@ -5,26 +5,60 @@ import (
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"postgres-explain/backend/enterprise/shared"
+	"time"
 )
 
+// dimensionFiltersSQL is added to the conditions of every query, the values are bound to filter_<index> by filtersQueryArgs
+const dimensionFiltersSQL = `{{ range $i, $filter := .Filters }}
+  AND {{ $filter.Column }} {{ if $filter.Exclude }}NOT {{ end }}IN (:filter_{{ $i }}){{ end }}`
+
+func filtersQueryArgs(queryArgs map[string]interface{}, filters []DimensionFilter) map[string]interface{} {
+	for i, filter := range filters {
+		queryArgs[fmt.Sprintf("filter_%d", i)] = filter.Values
+	}
+
+	return queryArgs
+}
+
+// Long ranges are read from the rollups, counts are summed so that every source returns the same slots.
+// A sample stands for period_length seconds of database time, a slot cannot be narrower than the sampling period.
 const waitEventProfilerSQLTemplate = `
-SELECT toStartOfMinute(period_start) AS slot, 
-       count() AS wait_event_count, 
+SELECT toStartOfInterval({{ .TimeColumn }}, INTERVAL {{ .StepSec }} SECOND) AS slot,
+       {{ if .IsRollup }}{{ .StepSec }}{{ else }}greatest({{ .StepSec }}, max(period_length)){{ end }} AS slot_length,
+       {{ if .IsRollup }}sum(wait_event_count){{ else }}count(){{ end }} AS wait_event_count, 
+       {{ if .IsRollup }}sum(db_time_secs){{ else }}sum(period_length){{ end }} AS db_time_secs,
        wait_event, 
-       groupArray(cpu_cores)[1] as cpu_cores
-FROM activities
-WHERE period_start > :period_start_from 
-  AND period_start < :period_start_to 
-  AND cluster_name = :cluster_name
-GROUP BY slot, wait_event
+       {{ if .IsRollup }}any(cpu_cores){{ else }}groupArray(cpu_cores)[1]{{ end }} as cpu_cores{{ if .GroupBy }},
+       {{ .GroupBy }} AS group_value{{ end }}
+FROM {{ .Table }}
+WHERE {{ .TimeColumn }} > :period_start_from 
+  AND {{ .TimeColumn }} < :period_start_to 
+  AND organization = :organization
+  AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+GROUP BY slot, wait_event{{ if .GroupBy }}, group_value{{ end }}
 ORDER BY slot ASC;`
 
+var activitiesSource = shared.Source{Table: "activities", TimeColumn: "period_start", Step: time.Minute}
+
+var activitiesRollups = []shared.Source{
+	{Table: "activities_1m", TimeColumn: "bucket_start", Step: time.Minute, IsRollup: true},
+	{Table: "activities_10m", TimeColumn: "bucket_start", Step: 10 * time.Minute, IsRollup: true},
+	{Table: "activities_1h", TimeColumn: "bucket_start", Step: time.Hour, IsRollup: true},
+}
+
 type Repository struct {
 	DB *sqlx.DB
+	// RetentionDays is the number of days of data kept for each table, the missing ones are kept forever
+	RetentionDays map[string]uint
+}
+
+func NewActivitiesRepository(db *sqlx.DB, retentionDays map[string]uint) Repository {
+	return Repository{DB: db, RetentionDays: retentionDays}
 }
 
-func NewActivitiesRepository(db *sqlx.DB) Repository {
-	return Repository{DB: db}
+// PickSource returns the table the profile of the args is read from, and the step of its slots
+func (ar Repository) PickSource(args QueryArgs) shared.Source {
+	return shared.PickSourceForStep(activitiesSource, activitiesRollups, args.Step, args.PeriodStartFromSec, ar.RetentionDays, time.Now())
 }
 
 func (ar Repository) Select(ctx context.Context, args QueryArgs) ([]SlotDB, error) {
@@ -32,12 +66,30 @@ func (ar Repository) Select(ctx context.Context, args QueryArgs) ([]SlotDB, erro
 		"period_start_from": args.PeriodStartFromSec,
 		"period_start_to":   args.PeriodStartToSec,
 		"cluster_name":      args.ClusterName,
+		"organization":      args.Organization,
+	}
+
+	source := ar.PickSource(args)
+	tmplArgs := struct {
+		shared.Source
+		StepSec int64
+		GroupBy string
+		Filters []DimensionFilter
+	}{
+		Source:  source,
+		StepSec: source.StepSec(),
+		GroupBy: args.GroupBy,
+		Filters: args.Filters,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, filtersQueryArgs(queryArgs, args.Filters), waitEventProfilerSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
 	}
 
 	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
 	defer cancel()
 
-	rows, err := ar.DB.NamedQueryContext(queryCtx, waitEventProfilerSQLTemplate, queryArgs)
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,34 +109,48 @@ func (ar Repository) Select(ctx context.Context, args QueryArgs) ([]SlotDB, erro
 	return slots, err
 }
 
+// With a group_by dimension the load of each wait event is split by the values of the dimension,
+// thus the loads are summed back by wait event and by group value.
+// The queries are ranked by the expression of the Ranking, computed from the aggregates of grouping.
 const topQueriesSQLTemplate = `
 WITH final AS (WITH grouping AS (SELECT fingerprint,
                                         groupArray(cpu_cores)[1]  AS cc,
                                         (count() / :period_duration ) / cc AS cpu_load_by_wait_event,
-                                        wait_event
+                                        sum(duration)                    AS duration_sum,
+                                        count()                          AS samples,
+                                        uniqExactState(instance_name, pid) AS sessions_state,
+                                        wait_event{{ if .GroupBy }},
+                                        {{ .GroupBy }} AS group_value{{ end }}
                                  FROM activities
                                  WHERE period_start > :period_start_from
                                    AND period_start < :period_start_to 
-                                   AND cluster_name = :cluster_name
-                                 GROUP BY wait_event, fingerprint)
-               SELECT fingerprint,
-                      maxMap(map(wait_event, cpu_load_by_wait_event)) AS cpu_load_wait_events,
-                      sum(cpu_load_by_wait_event)                     AS cpu_load_total
-               FROM grouping
+                                   AND organization = :organization
+                                   AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+                                 GROUP BY wait_event, fingerprint{{ if .GroupBy }}, group_value{{ end }})
+               SELECT grouping.fingerprint AS fingerprint,
+                      sumMap(map(wait_event, cpu_load_by_wait_event)) AS cpu_load_wait_events,
+                      {{ if .GroupBy }}sumMap(map(group_value, cpu_load_by_wait_event)) AS cpu_load_groups,{{ end }}
+                      sum(cpu_load_by_wait_event)                     AS cpu_load_total,
+                      ` + rankingSQL + `
+               FROM grouping` + rankingJoinSQL + `
                GROUP BY fingerprint
-               ORDER BY cpu_load_total DESC)
+               ORDER BY rank_value DESC)
 SELECT groupArray(acs.parsed_query)[1] AS parsed_query,
        groupArray(acs.query)[1]        AS query,
        cpu_load_total,
        cpu_load_wait_events,
+       {{ if .GroupBy }}cpu_load_groups,{{ end }}
+       rank_value,
        fingerprint,
        groupArray(acs.is_query_truncated)[1] AS is_query_truncated,
        groupArray(acs.is_not_explainable)[1] AS is_query_not_explainable
 FROM final
-         LEFT JOIN activities acs ON final.fingerprint = acs.fingerprint
-GROUP BY cpu_load_wait_events, cpu_load_total, fingerprint
-ORDER BY cpu_load_total DESC
-LIMIT 25`
+         LEFT JOIN (SELECT fingerprint, parsed_query, query, is_query_truncated, is_not_explainable
+                    FROM activities
+                    WHERE organization = :organization) acs ON final.fingerprint = acs.fingerprint
+GROUP BY cpu_load_wait_events, {{ if .GroupBy }}cpu_load_groups, {{ end }}cpu_load_total, rank_value, fingerprint
+ORDER BY rank_value DESC
+LIMIT :limit OFFSET :offset`
 
 func (ar Repository) GetQueriesByWaitEventCount(ctx context.Context, args QueryArgs) ([]QueryDB, error) {
 	queryArgs := map[string]interface{}{
@@ -92,38 +158,48 @@ func (ar Repository) GetQueriesByWaitEventCount(ctx context.Context, args QueryA
 		"period_start_to":   args.PeriodStartToSec,
 		"period_duration":   args.PeriodStartToSec - args.PeriodStartFromSec,
 		"cluster_name":      args.ClusterName,
+		"organization":      args.Organization,
 	}
-	return ar.getTopQueries(ctx, queryArgs, topQueriesSQLTemplate)
+	return ar.getTopQueries(ctx, args, queryArgs, topQueriesSQLTemplate)
 }
 
+// The queries of a fingerprint cannot be ranked by a statement metric, the analytics keep the fingerprints only
 const getTopQueriesByFingerprintTmpl = `WITH final AS (WITH grouping AS (SELECT query,
                                         groupArray(cpu_cores)[1] AS cc,
                                         (count() / :period_duration) / cc    AS cpu_load_by_wait_event,
+                                        sum(duration)                        AS duration_sum,
+                                        count()                              AS samples,
+                                        uniqExactState(instance_name, pid)   AS sessions_state,
                                         wait_event
                                  FROM activities
                                  WHERE period_start > :period_start_from
                                    AND period_start < :period_start_to
+                                   AND organization = :organization
                                    AND cluster_name = :cluster_name
-                                   AND fingerprint = :fingerprint
+                                   AND fingerprint = :fingerprint` + dimensionFiltersSQL + `
                                  GROUP BY wait_event, query)
                SELECT query,
                       maxMap(map(wait_event, cpu_load_by_wait_event)) AS cpu_load_wait_events,
-                      sum(cpu_load_by_wait_event)                     AS cpu_load_total
+                      sum(cpu_load_by_wait_event)                     AS cpu_load_total,
+                      ` + rankingSQL + `
                FROM grouping
                GROUP BY query
-               ORDER BY cpu_load_total DESC)
+               ORDER BY rank_value DESC)
 SELECT acs.query                             AS query,
        cpu_load_total,
        cpu_load_wait_events,
+       rank_value,
        groupArray(acs.is_query_truncated)[1] AS is_query_truncated,
        acs.query_sha                         AS query_sha,
        groupArray(acs.is_not_explainable)[1] AS is_query_not_explainable,
        fingerprint
 FROM final
-         LEFT JOIN activities acs ON final.query = acs.query
-GROUP BY cpu_load_wait_events, cpu_load_total, fingerprint, acs.query_sha, acs.query
-ORDER BY cpu_load_total DESC
-LIMIT 25`
+         LEFT JOIN (SELECT query, query_sha, is_query_truncated, is_not_explainable, fingerprint
+                    FROM activities
+                    WHERE organization = :organization) acs ON final.query = acs.query
+GROUP BY cpu_load_wait_events, cpu_load_total, rank_value, fingerprint, acs.query_sha, acs.query
+ORDER BY rank_value DESC
+LIMIT :limit OFFSET :offset`
 
 func (ar Repository) GetTopQueriesByFingerprint(ctx context.Context, args QueryArgs) ([]QueryDB, error) {
 	queryArgs := map[string]interface{}{
@@ -131,17 +207,37 @@ func (ar Repository) GetTopQueriesByFingerprint(ctx context.Context, args QueryA
 		"period_start_to":   args.PeriodStartToSec,
 		"period_duration":   args.PeriodStartToSec - args.PeriodStartFromSec,
 		"cluster_name":      args.ClusterName,
+		"organization":      args.Organization,
 		"fingerprint":       args.Fingerprint,
 	}
 
-	return ar.getTopQueries(ctx, queryArgs, getTopQueriesByFingerprintTmpl)
+	return ar.getTopQueries(ctx, args, queryArgs, getTopQueriesByFingerprintTmpl)
 }
 
-func (ar Repository) getTopQueries(ctx context.Context, args map[string]interface{}, tmpl string) ([]QueryDB, error) {
-	rows, err := ar.DB.NamedQueryContext(ctx, tmpl, args)
+func (ar Repository) getTopQueries(ctx context.Context, args QueryArgs, queryArgs map[string]interface{}, tmpl string) ([]QueryDB, error) {
+	queryArgs["limit"] = args.Limit
+	queryArgs["offset"] = args.Offset
+	queryArgs["rank_wait_events"] = args.Ranking.WaitEvents
+
+	tmplArgs := struct {
+		GroupBy string
+		Filters []DimensionFilter
+		Ranking Ranking
+	}{
+		GroupBy: args.GroupBy,
+		Filters: args.Filters,
+		Ranking: args.Ranking,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, filtersQueryArgs(queryArgs, args.Filters), tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	rows, err := ar.DB.QueryxContext(ctx, ar.DB.Rebind(query), queryArgsList...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	rankedQueries := make([]QueryDB, 0)
 	for rows.Next() {
@@ -156,14 +252,464 @@ func (ar Repository) getTopQueries(ctx context.Context, args map[string]interfac
 	return rankedQueries, nil
 }
 
-const getQueryMetadataByFingerprintTmpl = `SELECT datname, parsed_query, is_query_truncated FROM activities WHERE fingerprint = :fingerprint LIMIT 1`
-const getQueryMetadataByShaTmpl = `SELECT datname, query, is_query_truncated FROM activities WHERE query_sha = :query_sha LIMIT 1`
+// The clients are ranked by their average active sessions, the load relative to the cpu cores is computed as in topQueriesSQLTemplate
+const topClientsSQLTemplate = `
+WITH grouping AS (SELECT client_hostname,
+                         application_name,
+                         usename,
+                         wait_event,
+                         groupArray(cpu_cores)[1]      AS cc,
+                         count() / :period_duration    AS aas_by_wait_event
+                  FROM activities
+                  WHERE period_start > :period_start_from
+                    AND period_start < :period_start_to
+                    AND organization = :organization
+                    AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+                  GROUP BY client_hostname, application_name, usename, wait_event)
+SELECT client_hostname,
+       application_name,
+       usename,
+       sumMap(map(wait_event, aas_by_wait_event)) AS aas_wait_events,
+       sum(aas_by_wait_event)                     AS aas_total,
+       sum(aas_by_wait_event / cc)                AS cpu_load_total
+FROM grouping
+GROUP BY client_hostname, application_name, usename
+ORDER BY aas_total DESC
+LIMIT 25`
+
+func (ar Repository) GetTopClients(ctx context.Context, args QueryArgs) ([]ClientDB, error) {
+	queryArgs := map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"period_duration":   args.PeriodStartToSec - args.PeriodStartFromSec,
+		"cluster_name":      args.ClusterName,
+		"organization":      args.Organization,
+	}
+	tmplArgs := struct {
+		Filters []DimensionFilter
+	}{
+		Filters: args.Filters,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, filtersQueryArgs(queryArgs, args.Filters), topClientsSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
 
-func (ar Repository) GetQueryMetadataByFingerprint(ctx context.Context, fingerprint string) (*QueryMetadata, error) {
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := make([]ClientDB, 0)
+	for rows.Next() {
+		client := ClientDB{}
+		if err := rows.StructScan(&client); err != nil {
+			return nil, err
+		}
+
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+// maxSessionSamples bounds the samples of a session timeline, one day of samples taken every second.
+// The newest samples are kept, one more is selected to know whether older ones were left out.
+const maxSessionSamples = 86400
+
+const getSessionSamplesSQL = `
+SELECT "current_timestamp",
+       period_length,
+       state,
+       wait_event_type,
+       wait_event,
+       query_start,
+       backend_start,
+       fingerprint,
+       query_sha,
+       query,
+       duration,
+       usename,
+       application_name,
+       client_hostname,
+       datname
+FROM activities
+WHERE period_start >= :period_start_from
+  AND period_start <= :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name
+  AND instance_name = :instance_name
+  AND pid = :pid
+ORDER BY "current_timestamp" DESC
+LIMIT :limit`
+
+// GetSessionSamples returns the latest samples of a backend of an instance ordered by time,
+// truncated tells whether older samples of the period were left out.
+func (ar Repository) GetSessionSamples(ctx context.Context, args QueryArgs, instanceName string, pid uint32) (samples []SessionSampleDB, truncated bool, err error) {
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.NamedQueryContext(queryCtx, getSessionSamplesSQL, map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"organization":      args.Organization,
+		"cluster_name":      args.ClusterName,
+		"instance_name":     instanceName,
+		"pid":               pid,
+		"limit":             maxSessionSamples + 1,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("could not NamedQueryContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	samples = make([]SessionSampleDB, 0)
+	for rows.Next() {
+		sample := SessionSampleDB{}
+		if err := rows.StructScan(&sample); err != nil {
+			return nil, false, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		samples = append(samples, sample)
+	}
+
+	samples, truncated = latestSessionSamples(samples)
+	return samples, truncated, nil
+}
+
+const backendTypesLoadSQLTemplate = `
+SELECT backend_type,
+       count() / :period_duration AS aas
+FROM activities
+WHERE period_start > :period_start_from
+  AND period_start < :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+GROUP BY backend_type
+ORDER BY aas DESC`
+
+// The autovacuum workers report the table they process as their query
+const maintenanceLoadSQLTemplate = `
+SELECT datname,
+       query,
+       wait_event,
+       count() / :period_duration AS aas
+FROM activities
+WHERE period_start > :period_start_from
+  AND period_start < :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name
+  AND backend_type = :backend_type` + dimensionFiltersSQL + `
+GROUP BY datname, query, wait_event`
+
+func (ar Repository) GetBackendTypesLoad(ctx context.Context, args QueryArgs) ([]BackendTypeLoadDB, error) {
+	loads := make([]BackendTypeLoadDB, 0)
+	err := ar.selectLoads(ctx, args, nil, backendTypesLoadSQLTemplate, func(rows *sqlx.Rows) error {
+		load := BackendTypeLoadDB{}
+		if err := rows.StructScan(&load); err != nil {
+			return err
+		}
+
+		loads = append(loads, load)
+		return nil
+	})
+
+	return loads, err
+}
+
+func (ar Repository) GetMaintenanceLoad(ctx context.Context, args QueryArgs) ([]MaintenanceLoadDB, error) {
+	loads := make([]MaintenanceLoadDB, 0)
+	err := ar.selectLoads(ctx, args, nil, maintenanceLoadSQLTemplate, func(rows *sqlx.Rows) error {
+		load := MaintenanceLoadDB{}
+		if err := rows.StructScan(&load); err != nil {
+			return err
+		}
+
+		loads = append(loads, load)
+		return nil
+	})
+
+	return loads, err
+}
+
+// The load of the windows of a comparison is their number of samples spread over their duration, like the top queries
+const waitEventsLoadSQLTemplate = `
+SELECT wait_event,
+       count() / :period_duration AS aas
+FROM activities
+WHERE period_start > :period_start_from
+  AND period_start < :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+GROUP BY wait_event`
+
+// The fingerprints of both windows are compared in one query, so that the limit keeps the biggest changes
+// instead of labelling as new or gone the fingerprints ranked below the limit of one of the windows
+const fingerprintsLoadSQLTemplate = `
+SELECT fingerprint,
+       countIf(period_start > :baseline_period_start_from AND period_start < :baseline_period_start_to)
+           / :baseline_period_duration AS baseline_aas,
+       countIf(period_start > :period_start_from AND period_start < :period_start_to)
+           / :period_duration          AS aas
+FROM activities
+WHERE ((period_start > :baseline_period_start_from AND period_start < :baseline_period_start_to)
+    OR (period_start > :period_start_from AND period_start < :period_start_to))
+  AND organization = :organization
+  AND cluster_name = :cluster_name` + dimensionFiltersSQL + `
+GROUP BY fingerprint
+ORDER BY abs(aas - baseline_aas) DESC
+LIMIT 500`
+
+func (ar Repository) GetWaitEventsLoad(ctx context.Context, args QueryArgs) ([]WaitEventLoadDB, error) {
+	loads := make([]WaitEventLoadDB, 0)
+	err := ar.selectLoads(ctx, args, nil, waitEventsLoadSQLTemplate, func(rows *sqlx.Rows) error {
+		load := WaitEventLoadDB{}
+		if err := rows.StructScan(&load); err != nil {
+			return err
+		}
+
+		loads = append(loads, load)
+		return nil
+	})
+
+	return loads, err
+}
+
+// GetFingerprintsLoad returns the load of the fingerprints in the baseline window and in the window of the args
+func (ar Repository) GetFingerprintsLoad(ctx context.Context, baselineArgs, args QueryArgs) ([]FingerprintLoadDB, error) {
+	baselineQueryArgs := map[string]interface{}{
+		"baseline_period_start_from": baselineArgs.PeriodStartFromSec,
+		"baseline_period_start_to":   baselineArgs.PeriodStartToSec,
+		"baseline_period_duration":   baselineArgs.PeriodStartToSec - baselineArgs.PeriodStartFromSec,
+	}
+
+	loads := make([]FingerprintLoadDB, 0)
+	err := ar.selectLoads(ctx, args, baselineQueryArgs, fingerprintsLoadSQLTemplate, func(rows *sqlx.Rows) error {
+		load := FingerprintLoadDB{}
+		if err := rows.StructScan(&load); err != nil {
+			return err
+		}
+
+		loads = append(loads, load)
+		return nil
+	})
+
+	return loads, err
+}
+
+// selectLoads runs a load query of the range with the filters of the args and scans its rows,
+// extraArgs holds the parameters of the query which are not derived from the args
+func (ar Repository) selectLoads(ctx context.Context, args QueryArgs, extraArgs map[string]interface{}, tmpl string, scan func(rows *sqlx.Rows) error) error {
+	queryArgs := map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"period_duration":   args.PeriodStartToSec - args.PeriodStartFromSec,
+		"cluster_name":      args.ClusterName,
+		"organization":      args.Organization,
+		"backend_type":      autovacuumBackendType,
+	}
+	for name, value := range extraArgs {
+		queryArgs[name] = value
+	}
+	tmplArgs := struct {
+		Filters []DimensionFilter
+	}{
+		Filters: args.Filters,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, filtersQueryArgs(queryArgs, args.Filters), tmpl)
+	if err != nil {
+		return fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return fmt.Errorf("could not QueryxContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("could not StructScan: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// A transaction is a session with the same xact_start, the backend_xmin of 0 means no horizon is held.
+// The age and the idle time are computed from the samples in the db time, as current_timestamp.
+const longTransactionsSQLTemplate = `
+SELECT instance_name,
+       pid,
+       xact_start,
+       max("current_timestamp")                                                   AS last_seen,
+       max(toInt64(toUInt32("current_timestamp")) - xact_start)                   AS transaction_age_secs,
+       maxIf(toInt64(toUInt32("current_timestamp")) - state_change,
+             state IN ('idle in transaction', 'idle in transaction (aborted)')
+                 AND state_change > 0)                                            AS idle_in_transaction_secs,
+       minIf(backend_xmin, backend_xmin > 0)                                      AS backend_xmin,
+       argMax(datname, "current_timestamp")                                       AS datname,
+       argMax(usename, "current_timestamp")                                       AS usename,
+       argMax(application_name, "current_timestamp")                              AS application_name,
+       argMax(client_hostname, "current_timestamp")                               AS client_hostname,
+       argMax(state, "current_timestamp")                                         AS state,
+       argMax(fingerprint, "current_timestamp")                                   AS fingerprint,
+       argMax(query, "current_timestamp")                                         AS query
+FROM activities
+WHERE period_start >= :period_start_from
+  AND period_start <= :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name{{ if .InstanceName }}
+  AND instance_name = :instance_name{{ end }}
+  AND xact_start > 0
+GROUP BY instance_name, pid, xact_start
+HAVING transaction_age_secs >= :min_transaction_age
+    OR idle_in_transaction_secs >= :min_idle_in_transaction
+ORDER BY transaction_age_secs DESC
+LIMIT 100`
+
+// GetLongTransactions returns the transactions older or idle in transaction for longer than the thresholds
+func (ar Repository) GetLongTransactions(ctx context.Context, args QueryArgs, instanceName string, minTransactionAge, minIdleInTransaction time.Duration) ([]LongTransactionDB, error) {
+	queryArgs := map[string]interface{}{
+		"period_start_from":       args.PeriodStartFromSec,
+		"period_start_to":         args.PeriodStartToSec,
+		"organization":            args.Organization,
+		"cluster_name":            args.ClusterName,
+		"instance_name":           instanceName,
+		"min_transaction_age":     int64(minTransactionAge / time.Second),
+		"min_idle_in_transaction": int64(minIdleInTransaction / time.Second),
+	}
+	tmplArgs := struct {
+		InstanceName string
+	}{
+		InstanceName: instanceName,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, queryArgs, longTransactionsSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return nil, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	transactions := make([]LongTransactionDB, 0)
+	for rows.Next() {
+		transaction := LongTransactionDB{}
+		if err := rows.StructScan(&transaction); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// maxLockSamples bounds the samples of the lock tree
+const maxLockSamples = 100000
+
+const lockSamplesConditionsSQL = `
+  period_start >= :period_start_from
+  AND period_start <= :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name{{ if .InstanceName }}
+  AND instance_name = :instance_name{{ end }}`
+
+// The blocked sessions are read with their blockers, sampled at the same time
+const getLockSamplesSQLTemplate = `
+SELECT instance_name,
+       "current_timestamp",
+       period_length,
+       pid,
+       blocking_pids,
+       locked_relation,
+       lock_mode,
+       state,
+       wait_event_type,
+       wait_event,
+       usename,
+       application_name,
+       fingerprint,
+       query
+FROM activities
+WHERE` + lockSamplesConditionsSQL + `
+  AND (notEmpty(blocking_pids) OR (instance_name, "current_timestamp", pid) IN (
+        SELECT instance_name, "current_timestamp", arrayJoin(blocking_pids)
+        FROM activities
+        WHERE` + lockSamplesConditionsSQL + `
+          AND notEmpty(blocking_pids)))
+ORDER BY "current_timestamp" ASC, instance_name ASC, pid ASC
+LIMIT :limit`
+
+// GetLockSamples returns the samples of the blocked sessions and of their blockers ordered by time
+func (ar Repository) GetLockSamples(ctx context.Context, args QueryArgs, instanceName string) ([]LockSampleDB, error) {
+	queryArgs := map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"organization":      args.Organization,
+		"cluster_name":      args.ClusterName,
+		"instance_name":     instanceName,
+		"limit":             maxLockSamples,
+	}
+	tmplArgs := struct {
+		InstanceName string
+	}{
+		InstanceName: instanceName,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, queryArgs, getLockSamplesSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return nil, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	samples := make([]LockSampleDB, 0)
+	for rows.Next() {
+		sample := LockSampleDB{}
+		if err := rows.StructScan(&sample); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+const getQueryMetadataByFingerprintTmpl = `SELECT datname, parsed_query, is_query_truncated FROM activities WHERE organization = :organization AND fingerprint = :fingerprint LIMIT 1`
+const getQueryMetadataByShaTmpl = `SELECT datname, query, is_query_truncated FROM activities WHERE organization = :organization AND query_sha = :query_sha LIMIT 1`
+
+func (ar Repository) GetQueryMetadataByFingerprint(ctx context.Context, organization, fingerprint string) (*QueryMetadata, error) {
 	metadata, err := ar.getQueryMetadata(ctx, getQueryMetadataByFingerprintTmpl, struct {
-		Fingerprint string `json:"fingerprint"`
+		Organization string `json:"organization"`
+		Fingerprint  string `json:"fingerprint"`
 	}{
-		Fingerprint: fingerprint,
+		Organization: organization,
+		Fingerprint:  fingerprint,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not getQueryMetadata with fingerprint %v: %v", fingerprint, err)
@@ -176,11 +722,13 @@ func (ar Repository) GetQueryMetadataByFingerprint(ctx context.Context, fingerpr
 	return nil, nil
 }
 
-func (ar Repository) GetQueryMetadataBySha(ctx context.Context, sha string) (*QueryMetadata, error) {
+func (ar Repository) GetQueryMetadataBySha(ctx context.Context, organization, sha string) (*QueryMetadata, error) {
 	metadata, err := ar.getQueryMetadata(ctx, getQueryMetadataByShaTmpl, struct {
-		Sha string `json:"query_sha"`
+		Organization string `json:"organization"`
+		Sha          string `json:"query_sha"`
 	}{
-		Sha: sha,
+		Organization: organization,
+		Sha:          sha,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not getQueryMetadata with sha %v: %v", sha, err)
@@ -216,3 +764,107 @@ func (ar Repository) getQueryMetadata(ctx context.Context, tmpl string, args int
 
 	return metadata, nil
 }
+
+// A query running for several samples is one execution, identified by its backend and its start,
+// its latency is the longest duration seen by the samples. The executions shorter than the sampling period are mostly missed.
+const latencyDistributionSQLTemplate = `
+WITH executions AS (SELECT max(duration)     AS duration,
+                           min(period_start) AS started
+                    FROM activities
+                    WHERE period_start > :period_start_from
+                      AND period_start < :period_start_to
+                      AND organization = :organization
+                      AND cluster_name = :cluster_name
+                      AND fingerprint = :fingerprint
+                      AND state = 'active'` + dimensionFiltersSQL + `
+                    GROUP BY instance_name, pid, query_start)
+SELECT toStartOfInterval(started, INTERVAL {{ .StepSec }} SECOND) AS slot,
+       if(duration < :min_latency_secs, 0,
+          least(toUInt32(floor(log2(duration / :min_latency_secs))) + 1, :last_bucket)) AS bucket,
+       count() AS executions
+FROM executions
+GROUP BY slot, bucket
+ORDER BY slot, bucket`
+
+// GetLatencyDistribution returns the executions of a fingerprint by slot and by bucket of the latency histogram
+func (ar Repository) GetLatencyDistribution(ctx context.Context, args QueryArgs) ([]LatencyDB, error) {
+	queryArgs := map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"organization":      args.Organization,
+		"cluster_name":      args.ClusterName,
+		"fingerprint":       args.Fingerprint,
+		"min_latency_secs":  minLatencySecs,
+		"last_bucket":       latencyBuckets - 1,
+	}
+	tmplArgs := struct {
+		StepSec int64
+		Filters []DimensionFilter
+	}{
+		StepSec: int64(args.Step / time.Second),
+		Filters: args.Filters,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(tmplArgs, filtersQueryArgs(queryArgs, args.Filters), latencyDistributionSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.QueryxContext(queryCtx, ar.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return nil, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	latencies := make([]LatencyDB, 0)
+	for rows.Next() {
+		latency := LatencyDB{}
+		if err := rows.StructScan(&latency); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		latencies = append(latencies, latency)
+	}
+
+	return latencies, nil
+}
+
+// Only the wait events of the recent samples are read, the catalog of an organization is filled again after a restart
+const recentWaitEventsSQL = `
+SELECT DISTINCT wait_event_type,
+                wait_event
+FROM activities
+WHERE organization = :organization
+  AND period_start > :period_start_from
+  AND wait_event != ''`
+
+// GetRecentWaitEvents returns the wait events reported by the collectors of an organization since the given time
+func (ar Repository) GetRecentWaitEvents(ctx context.Context, organization string, from time.Time) ([]WaitEventNameDB, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := ar.DB.NamedQueryContext(queryCtx, recentWaitEventsSQL, map[string]interface{}{
+		"organization":      organization,
+		"period_start_from": from,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not NamedQueryContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	waitEvents := make([]WaitEventNameDB, 0)
+	for rows.Next() {
+		waitEvent := WaitEventNameDB{}
+		if err := rows.StructScan(&waitEvent); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		waitEvents = append(waitEvents, waitEvent)
+	}
+
+	return waitEvents, nil
+}
@@ -0,0 +1,126 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"postgres-explain/backend/enterprise/shared"
+	"strings"
+	"testing"
+)
+
+func TestDimensionFiltersSQL(t *testing.T) {
+	filters := []DimensionFilter{
+		{Column: "usename", Values: []string{"etl", "backup"}, Exclude: true},
+		{Column: "datname", Values: []string{"shop"}},
+	}
+	queryArgs := filtersQueryArgs(map[string]interface{}{
+		"period_start_from": 1,
+		"period_start_to":   2,
+		"period_duration":   1,
+		"organization":      "default",
+		"cluster_name":      "cluster",
+		"fingerprint":       "fingerprint",
+		"limit":             25,
+		"offset":            0,
+		"min_latency_secs":  minLatencySecs,
+		"last_bucket":       latencyBuckets - 1,
+
+		"baseline_period_start_from": 0,
+		"baseline_period_start_to":   1,
+		"baseline_period_duration":   1,
+	}, filters)
+	tmplArgs := struct {
+		shared.Source
+		StepSec int64
+		GroupBy string
+		Filters []DimensionFilter
+		Ranking Ranking
+	}{
+		Source:  activitiesSource,
+		StepSec: 60,
+		Filters: filters,
+	}
+
+	templates := []string{
+		waitEventProfilerSQLTemplate,
+		topQueriesSQLTemplate,
+		getTopQueriesByFingerprintTmpl,
+		topClientsSQLTemplate,
+		backendTypesLoadSQLTemplate,
+		waitEventsLoadSQLTemplate,
+		fingerprintsLoadSQLTemplate,
+		latencyDistributionSQLTemplate,
+	}
+	for _, tmpl := range templates {
+		query, args, err := shared.ProcessQueryWithTemplate(tmplArgs, queryArgs, tmpl)
+		assert.NoError(t, err)
+		assert.Contains(t, query, "AND usename NOT IN (?, ?)")
+		assert.Contains(t, query, "AND datname IN (?)")
+		assert.Equal(t, strings.Count(query, "?"), len(args))
+		assert.Subset(t, args, []interface{}{"etl", "backup", "shop"})
+	}
+}
+
+func TestGetLockSamplesSQLTemplate(t *testing.T) {
+	queryArgs := map[string]interface{}{
+		"period_start_from": 1,
+		"period_start_to":   2,
+		"organization":      "default",
+		"cluster_name":      "cluster",
+		"instance_name":     "primary",
+		"limit":             maxLockSamples,
+	}
+
+	query, args, err := shared.ProcessQueryWithTemplate(struct{ InstanceName string }{}, queryArgs, getLockSamplesSQLTemplate)
+	assert.NoError(t, err)
+	assert.NotContains(t, query, "instance_name = ?")
+	assert.Equal(t, strings.Count(query, "?"), len(args))
+
+	query, args, err = shared.ProcessQueryWithTemplate(struct{ InstanceName string }{InstanceName: "primary"}, queryArgs, getLockSamplesSQLTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(query, "instance_name = ?"))
+	assert.Equal(t, strings.Count(query, "?"), len(args))
+}
+
+func TestLongTransactionsSQLTemplate(t *testing.T) {
+	queryArgs := map[string]interface{}{
+		"period_start_from":       1,
+		"period_start_to":         2,
+		"organization":            "default",
+		"cluster_name":            "cluster",
+		"instance_name":           "primary",
+		"min_transaction_age":     300,
+		"min_idle_in_transaction": 60,
+	}
+
+	query, args, err := shared.ProcessQueryWithTemplate(struct{ InstanceName string }{InstanceName: "primary"}, queryArgs, longTransactionsSQLTemplate)
+	assert.NoError(t, err)
+	assert.Contains(t, query, "AND instance_name = ?")
+	assert.Equal(t, strings.Count(query, "?"), len(args))
+	assert.Subset(t, args, []interface{}{300, 60})
+	assert.Contains(t, query, "AND state_change > 0")
+}
+
+func TestFingerprintsLoadSQLTemplate(t *testing.T) {
+	queryArgs := filtersQueryArgs(map[string]interface{}{
+		"period_start_from":          3600,
+		"period_start_to":            7200,
+		"period_duration":            3600,
+		"baseline_period_start_from": 0,
+		"baseline_period_start_to":   1800,
+		"baseline_period_duration":   1800,
+		"organization":               "default",
+		"cluster_name":               "cluster",
+	}, nil)
+
+	query, args, err := shared.ProcessQueryWithTemplate(struct{ Filters []DimensionFilter }{}, queryArgs, fingerprintsLoadSQLTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Count(query, "?"), len(args))
+	assert.Equal(t, 2, strings.Count(query, "countIf("))
+	assert.Less(t, strings.Index(query, "ORDER BY abs(aas - baseline_aas)"), strings.Index(query, "LIMIT 500"))
+}
+
+func TestLongTransactionDB_ToProto(t *testing.T) {
+	transaction := LongTransactionDB{TransactionAgeSecs: -2, IdleInTransactionSecs: 90}.ToProto()
+	assert.Equal(t, uint32(0), transaction.TransactionAgeSecs)
+	assert.Equal(t, uint32(90), transaction.IdleInTransactionSecs)
+}
@@ -6,17 +6,30 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/backend/cache"
+	"postgres-explain/backend/enterprise/anomalies"
 	"postgres-explain/backend/enterprise/shared"
+	"postgres-explain/backend/organization"
 	sharedBackend "postgres-explain/backend/shared"
 	"postgres-explain/proto"
 	"time"
 )
 
+// recentWaitEventsWindow is the window of the samples whose wait events are registered again after a restart
+const recentWaitEventsWindow = 24 * time.Hour
+
 type Service struct {
 	Repo          Repository
 	MetricsRepo   shared.MetricsRepository
-	WaitEventsMap map[string]WaitEvent
-	log           *logrus.Entry
+	AnomaliesRepo anomalies.Repository
+	WaitEvents    *WaitEventCatalog
+	// Cache holds the instances registered by the collectors with their server version
+	Cache *cache.Client
+	// Live receives the batches of the collector for the live streams
+	Live *LiveBroker
+	// Sampler stores the samples received by the collector
+	Sampler *ActivitySampler
+	log     *logrus.Entry
 
 	proto.ActivitiesServer
 }
@@ -24,13 +37,21 @@ type Service struct {
 func NewService(
 	repo Repository,
 	metricsRepo shared.MetricsRepository,
-	waitEventsMap map[string]WaitEvent,
+	anomaliesRepo anomalies.Repository,
+	waitEvents *WaitEventCatalog,
+	cacheClient *cache.Client,
+	live *LiveBroker,
+	sampler *ActivitySampler,
 	log *logrus.Entry,
 ) *Service {
 	return &Service{
 		Repo:          repo,
-		WaitEventsMap: waitEventsMap,
+		WaitEvents:    waitEvents,
+		Cache:         cacheClient,
+		Live:          live,
+		Sampler:       sampler,
 		MetricsRepo:   metricsRepo,
+		AnomaliesRepo: anomaliesRepo,
 		log:           log.WithField("subcomponent", "activity_profiler"),
 	}
 }
@@ -44,18 +65,50 @@ func (aps *Service) GetProfile(ctx context.Context, in *proto.GetProfileRequest)
 		return nil, err
 	}
 
-	results, err := aps.Repo.Select(ctx, QueryArgs{
+	group, err := getRequestGroup(in.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	view, err := newProfileView(waitEvents, in.ByClass, in.WaitEventClass)
+	if err != nil {
+		return nil, err
+	}
+	step, err := getRequestStep(in.Step, in.PeriodStartFrom.Seconds, in.PeriodStartTo.Seconds)
+	if err != nil {
+		return nil, err
+	}
+
+	args := QueryArgs{
+		Organization:       organization.FromContext(ctx),
 		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
 		PeriodStartToSec:   in.PeriodStartTo.Seconds,
 		ClusterName:        in.ClusterName,
-	})
+		GroupBy:            group.ID,
+		Filters:            filters,
+		Step:               step,
+	}
+	// the step is coarser than requested when the period is only covered by a rollup
+	args.Step = aps.Repo.PickSource(args).Step
+	results, err := aps.Repo.Select(ctx, args)
 	if err != nil {
 		aps.log.Errorf("error querying clickhouse: %v", err)
 		return &proto.GetProfileResponse{}, fmt.Errorf("something went wrong")
 	}
 
+	stepSecs := uint32(args.Step / time.Second)
+	slotsWaitEvents := make([]string, 0, len(results))
+	for _, result := range results {
+		slotsWaitEvents = append(slotsWaitEvents, result.WaitEventName)
+	}
+	view.add(aps.WaitEvents.addMissing(organization.FromContext(ctx), waitEvents, slotsWaitEvents))
+	results = view.filter(results)
 	if len(results) == 0 {
-		return &proto.GetProfileResponse{}, nil
+		return &proto.GetProfileResponse{StepSecs: stepSecs}, nil
 	}
 
 	// TODO document this and maybe optimize
@@ -63,13 +116,39 @@ func (aps *Service) GetProfile(ctx context.Context, in *proto.GetProfileRequest)
 	// thus we transform to slot data structure to make it more convenient.
 	// This could be optimized later
 	slots, ascOrderedUniqueTimestamps := aps.getSlots(results)
-	traces := aps.mapSlotsToTraces(slots, ascOrderedUniqueTimestamps)
+	traces := aps.mapSlotsToTraces(view.aggregate(slots), ascOrderedUniqueTimestamps, view.waitEvents)
 	//TODO find a better method to set cpu_cores
 	var cpuCores float32 = 0
 	if len(results) > 0 {
 		cpuCores = results[0].CpuCores
 	}
-	return &proto.GetProfileResponse{Traces: traces, CurrentCpuCores: cpuCores}, nil
+
+	response := &proto.GetProfileResponse{
+		Traces:          traces,
+		CurrentCpuCores: cpuCores,
+		WaitEvents:      toProtoWaitEvents(view.waitEvents, traces),
+		StepSecs:        stepSecs,
+	}
+	if group.ID != "" {
+		response.Groups = aps.mapGroupsToProfiles(results, ascOrderedUniqueTimestamps, view)
+		response.Group = group.ToProto()
+	}
+
+	// the anomalies are an overlay, the profile is returned without them if they cannot be read
+	clusterAnomalies, err := aps.AnomaliesRepo.Select(ctx, anomalies.AnomaliesArgs{
+		Organization:       organization.FromContext(ctx),
+		ClusterName:        in.ClusterName,
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		Kind:               anomalies.KindAAS,
+	})
+	if err != nil {
+		aps.log.Warnf("could not get the anomalies of the profile: %v", err)
+	} else {
+		response.Anomalies = anomalies.ToProto(clusterAnomalies)
+	}
+
+	return response, nil
 }
 
 func (aps *Service) GetTopQueries(ctx context.Context, in *proto.GetTopQueriesRequest) (*proto.GetTopQueriesResponse, error) {
@@ -81,11 +160,40 @@ func (aps *Service) GetTopQueries(ctx context.Context, in *proto.GetTopQueriesRe
 		return nil, err
 	}
 
+	group, err := getRequestGroup(in.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := getRequestPage(in.Limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	ranking, err := parseRanking(in.RankBy, waitEvents)
+	if err != nil {
+		return nil, err
+	}
+
 	args := QueryArgs{
+		Organization:       organization.FromContext(ctx),
 		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
 		PeriodStartToSec:   in.PeriodStartTo.Seconds,
 		ClusterName:        in.ClusterName,
+		GroupBy:            group.ID,
+		Filters:            filters,
+		Limit:              limit,
+		Offset:             offset,
+		Ranking:            ranking,
 	}
+	baselineArgs, err := getBaselineArgs(args, in.BaselinePeriodStartFrom, in.BaselinePeriodStartTo)
+	if err != nil {
+		return nil, err
+	}
+
 	queries, err := aps.Repo.GetQueriesByWaitEventCount(ctx, args)
 	if err != nil {
 		aps.log.Errorf("error querying clickhouse: %v", err)
@@ -105,15 +213,344 @@ func (aps *Service) GetTopQueries(ctx context.Context, in *proto.GetTopQueriesRe
 		return query.Fingerprint
 	}
 	metadata := aps.getQueriesMetadata(ctx, queries, xValueGetter)
-	traces := aps.mapQueriesToTraces(queries, xValueGetter)
+	traces := aps.mapQueriesToTraces(organization.FromContext(ctx), queries, xValueGetter, waitEvents)
 
-	return &proto.GetTopQueriesResponse{
+	response := &proto.GetTopQueriesResponse{
 		Traces:          traces,
 		QueriesMetrics:  queriesMetrics,
 		QueriesMetadata: metadata,
+		WaitEvents:      toProtoWaitEvents(waitEvents, traces),
+		RankValues:      toRankValues(queries, xValueGetter),
+	}
+	if group.ID != "" {
+		response.GroupsTraces = aps.mapQueriesToGroupsTraces(queries, xValueGetter)
+		response.Group = group.ToProto()
+	}
+	if baselineArgs != nil {
+		response.Comparison, err = aps.compareLoad(ctx, *baselineArgs, args)
+		if err != nil {
+			aps.log.Errorf("error querying clickhouse: %v", err)
+			return &proto.GetTopQueriesResponse{}, fmt.Errorf("something went wrong")
+		}
+
+		// the metrics of the baseline may be missing, the comparison of the load is still returned
+		baselineMetrics, _, err := aps.getMetricsForTopQueries(ctx, *baselineArgs, queries)
+		if err != nil {
+			aps.log.Warnf("could not get the metrics of the baseline: %v", err)
+		} else {
+			response.Comparison.MetricsChanges = compareQueriesMetrics(baselineMetrics, queriesMetrics)
+		}
+	}
+
+	return response, nil
+}
+
+// getBaselineArgs returns the args of the baseline window of a comparison, nil when no window is set
+func getBaselineArgs(args QueryArgs, baselineFrom, baselineTo *timestamppb.Timestamp) (*QueryArgs, error) {
+	if baselineFrom == nil && baselineTo == nil {
+		return nil, nil
+	}
+	if baselineFrom == nil || baselineTo == nil {
+		return nil, fmt.Errorf("baseline_period_start_from and baseline_period_start_to must be set together")
+	}
+	if baselineFrom.Seconds >= baselineTo.Seconds {
+		return nil, fmt.Errorf("baseline-from-date %s must be before baseline-to-date %s", baselineFrom, baselineTo)
+	}
+
+	args.PeriodStartFromSec = baselineFrom.Seconds
+	args.PeriodStartToSec = baselineTo.Seconds
+	return &args, nil
+}
+
+// compareLoad compares the load by wait event and by fingerprint of a period with its baseline
+func (aps *Service) compareLoad(ctx context.Context, baselineArgs, args QueryArgs) (*proto.LoadComparison, error) {
+	baselineWaitEvents, err := aps.Repo.GetWaitEventsLoad(ctx, baselineArgs)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetWaitEventsLoad of the baseline: %v", err)
+	}
+	waitEvents, err := aps.Repo.GetWaitEventsLoad(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetWaitEventsLoad: %v", err)
+	}
+	fingerprints, err := aps.Repo.GetFingerprintsLoad(ctx, baselineArgs, args)
+	if err != nil {
+		return nil, fmt.Errorf("could not GetFingerprintsLoad: %v", err)
+	}
+
+	waitEventsDeltas, total := compareWaitEvents(baselineWaitEvents, waitEvents)
+	return &proto.LoadComparison{
+		Total:        total,
+		WaitEvents:   waitEventsDeltas,
+		Fingerprints: compareFingerprints(fingerprints),
+	}, nil
+}
+
+func (aps *Service) CompareProfiles(ctx context.Context, in *proto.CompareProfilesRequest) (*proto.CompareProfilesResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	args := QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+		Filters:            filters,
+	}
+	baselineArgs, err := getBaselineArgs(args, in.BaselinePeriodStartFrom, in.BaselinePeriodStartTo)
+	if err != nil {
+		return nil, err
+	}
+	if baselineArgs == nil {
+		return nil, fmt.Errorf("baseline_period_start_from and baseline_period_start_to are missing")
+	}
+
+	comparison, err := aps.compareLoad(ctx, *baselineArgs, args)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.CompareProfilesResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	aps.WaitEvents.addMissing(organization.FromContext(ctx), waitEvents, waitEventNames(comparison.WaitEvents))
+	protoWaitEvents := make(map[string]*proto.WaitEvent, len(comparison.WaitEvents))
+	for waitEventName := range comparison.WaitEvents {
+		protoWaitEvents[waitEventName] = waitEvents[waitEventName].toProto()
+	}
+
+	return &proto.CompareProfilesResponse{Comparison: comparison, WaitEvents: protoWaitEvents}, nil
+}
+
+func (aps *Service) GetTopClients(ctx context.Context, in *proto.GetTopClientsRequest) (*proto.GetTopClientsResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := aps.Repo.GetTopClients(ctx, QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+		Filters:            filters,
+	})
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetTopClientsResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	response := &proto.GetTopClientsResponse{
+		Clients:    make([]*proto.Client, 0, len(clients)),
+		WaitEvents: make(map[string]*proto.WaitEvent),
+	}
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	for _, client := range clients {
+		protoClient := client.ToProto()
+		response.Clients = append(response.Clients, protoClient)
+		aps.WaitEvents.addMissing(organization.FromContext(ctx), waitEvents, waitEventNames(protoClient.AasWaitEvents))
+		for waitEventName := range protoClient.AasWaitEvents {
+			response.WaitEvents[waitEventName] = waitEvents[waitEventName].toProto()
+		}
+	}
+
+	return response, nil
+}
+
+func (aps *Service) GetQueryLatencyDistribution(ctx context.Context, in *proto.GetQueryLatencyDistributionRequest) (*proto.GetQueryLatencyDistributionResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+	if in.Fingerprint == "" {
+		return nil, fmt.Errorf("fingerprint is missing")
+	}
+
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+	step, err := getRequestStep(in.Step, in.PeriodStartFrom.Seconds, in.PeriodStartTo.Seconds)
+	if err != nil {
+		return nil, err
+	}
+
+	latencies, err := aps.Repo.GetLatencyDistribution(ctx, QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+		Fingerprint:        in.Fingerprint,
+		Filters:            filters,
+		Step:               step,
+	})
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetQueryLatencyDistributionResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	buckets, executions := toLatencyHistogram(latencies)
+	return &proto.GetQueryLatencyDistributionResponse{
+		Buckets:    buckets,
+		Heatmap:    toLatencyHeatmap(latencies),
+		StepSecs:   uint32(step / time.Second),
+		Executions: executions,
+		P50Secs:    latencyPercentile(buckets, executions, 0.5),
+		P95Secs:    latencyPercentile(buckets, executions, 0.95),
+		P99Secs:    latencyPercentile(buckets, executions, 0.99),
 	}, nil
 }
 
+// StreamActivity sends the batches of samples of the cluster matching the filters until the client goes away
+func (aps *Service) StreamActivity(in *proto.StreamActivityRequest, stream proto.Activities_StreamActivityServer) error {
+	if in.ClusterName == "" {
+		return fmt.Errorf("cluster_name is missing")
+	}
+
+	queryFilters, err := parseQueryFilters(in.Filter)
+	if err != nil {
+		return err
+	}
+	filters, err := toDimensionFilters(append(in.Filters, queryFilters...))
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	subscription := aps.Live.Subscribe(organization.FromContext(ctx), in.ClusterName, filters)
+	defer aps.Live.Unsubscribe(subscription)
+
+	heartbeat := time.NewTicker(liveHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		response := &proto.StreamActivityResponse{}
+		select {
+		case <-ctx.Done():
+			return nil
+		case samples := <-subscription.Batches():
+			response.ActivitySamples = samples
+			heartbeat.Reset(liveHeartbeatInterval)
+		case <-heartbeat.C:
+			// nothing
+		}
+
+		response.ReceivedAt = timestamppb.Now()
+		response.DroppedBatches = subscription.Dropped()
+		if err := stream.Send(response); err != nil {
+			return fmt.Errorf("could not Send: %v", err)
+		}
+	}
+}
+
+func (aps *Service) GetIngestionStats(ctx context.Context, in *proto.GetIngestionStatsRequest) (*proto.GetIngestionStatsResponse, error) {
+	stats := aps.Sampler.Stats(organization.FromContext(ctx))
+	return &proto.GetIngestionStatsResponse{
+		DroppedSamples: stats.DroppedSamples,
+		FailedSamples:  stats.FailedSamples,
+		QueuedRequests: uint32(stats.QueuedRequests),
+		QueueSize:      uint32(stats.QueueSize),
+		QueuePolicy:    string(stats.QueuePolicy),
+	}, nil
+}
+
+// getWaitEvents returns the wait events of the version of PostgreSQL of the cluster with the ones registered for the organization,
+// the wait events of the recent samples of the organization are registered again on its first request since the start
+func (aps *Service) getWaitEvents(ctx context.Context, clusterName string) map[string]WaitEvent {
+	org := organization.FromContext(ctx)
+	err := aps.WaitEvents.LoadOnce(org, func() ([]WaitEventNameDB, error) {
+		return aps.Repo.GetRecentWaitEvents(ctx, org, time.Now().Add(-recentWaitEventsWindow))
+	})
+	if err != nil {
+		aps.log.Warnf("could not GetRecentWaitEvents: %v", err)
+	}
+
+	return aps.WaitEvents.ForServerVersion(org, aps.getServerVersion(ctx, clusterName))
+}
+
+// getServerVersion returns the server version reported by the collector of the primary of the cluster,
+// the highest version of its instances without primary, 0 when no collector reported it
+func (aps *Service) getServerVersion(ctx context.Context, clusterName string) uint32 {
+	if aps.Cache == nil {
+		return 0
+	}
+
+	instances, err := aps.Cache.GetClusterInstances(ctx, organization.FromContext(ctx), clusterName)
+	if err != nil {
+		aps.log.Warnf("could not get the instances of cluster %v: %v", clusterName, err)
+		return 0
+	}
+
+	var serverVersionNum uint32
+	for _, instance := range instances {
+		if instance.IsPrimary() && instance.ServerVersionNum != 0 {
+			return instance.ServerVersionNum
+		}
+		if instance.ServerVersionNum > serverVersionNum {
+			serverVersionNum = instance.ServerVersionNum
+		}
+	}
+
+	return serverVersionNum
+}
+
+// getRequestGroup returns the group of the group_by of a request, an empty group if it is not set
+func getRequestGroup(groupBy string) (Group, error) {
+	if groupBy == "" {
+		return Group{}, nil
+	}
+
+	return waitEventsGroupsMap.getGroup(groupBy)
+}
+
+const (
+	minStep = time.Second
+	maxStep = time.Hour
+)
+
+// getRequestStep parses the requested width of the slots, it is derived from the range when empty or auto.
+// The range must not hold more than shared.MaxRequestedStepPoints slots.
+func getRequestStep(step string, periodStartFromSec, periodStartToSec int64) (time.Duration, error) {
+	duration := shared.AutoStep(periodStartFromSec, periodStartToSec)
+	if step != "" && step != "auto" {
+		var err error
+		if duration, err = time.ParseDuration(step); err != nil {
+			return 0, fmt.Errorf("step %v is not valid: %v", step, err)
+		}
+		if duration < minStep || duration > maxStep {
+			return 0, fmt.Errorf("step must be between %v and %v", minStep, maxStep)
+		}
+		if duration%time.Second != 0 {
+			return 0, fmt.Errorf("step must be a whole number of seconds")
+		}
+	}
+
+	if points := time.Duration(periodStartToSec-periodStartFromSec) * time.Second / duration; points > shared.MaxRequestedStepPoints {
+		return 0, fmt.Errorf("step %v gives %v slots over the range, at most %v are allowed: use a coarser step or a shorter range", duration, points, shared.MaxRequestedStepPoints)
+	}
+
+	return duration, nil
+}
+
 func (aps *Service) GetTopQueriesByFingerprint(ctx context.Context, in *proto.GetTopQueriesRequest) (*proto.GetTopQueriesByFingerprintResponse, error) {
 	if err := shared.ValidateCommonRequestProps(shared.Validate{
 		PeriodStartFrom: in.PeriodStartFrom,
@@ -123,11 +560,34 @@ func (aps *Service) GetTopQueriesByFingerprint(ctx context.Context, in *proto.Ge
 		return nil, err
 	}
 
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := getRequestPage(in.Limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	ranking, err := parseRanking(in.RankBy, waitEvents)
+	if err != nil {
+		return nil, err
+	}
+	if ranking.Key == rankByMetric {
+		return nil, fmt.Errorf("the queries of a fingerprint cannot be ranked by a metric")
+	}
+
 	args := QueryArgs{
+		Organization:       organization.FromContext(ctx),
 		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
 		PeriodStartToSec:   in.PeriodStartTo.Seconds,
 		ClusterName:        in.ClusterName,
 		Fingerprint:        in.Fingerprint,
+		Filters:            filters,
+		Limit:              limit,
+		Offset:             offset,
+		Ranking:            ranking,
 	}
 	queries, err := aps.Repo.GetTopQueriesByFingerprint(ctx, args)
 	if err != nil {
@@ -147,12 +607,14 @@ func (aps *Service) GetTopQueriesByFingerprint(ctx context.Context, in *proto.Ge
 		return query.QuerySha
 	}
 	metadata := aps.getQueriesMetadata(ctx, queries, xValueGetter)
-	traces := aps.mapQueriesToTraces(queries, xValueGetter)
+	traces := aps.mapQueriesToTraces(organization.FromContext(ctx), queries, xValueGetter, waitEvents)
 
 	return &proto.GetTopQueriesByFingerprintResponse{
 		Traces:          traces,
 		QueriesMetadata: metadata,
 		QueryMetrics:    queriesMetrics[queries[0].Fingerprint],
+		WaitEvents:      toProtoWaitEvents(waitEvents, traces),
+		RankValues:      toRankValues(queries, xValueGetter),
 	}, nil
 }
 
@@ -169,7 +631,11 @@ func (aps *Service) GetQueryDetails(ctx context.Context, in *proto.GetQueryDetai
 
 	queryMetricsByFingerprint, err := aps.MetricsRepo.SelectQueryMetricsByFingerprint(
 		ctx,
-		shared.MetricsGetArgs{PeriodStartFromSec: periodStartFromSec, PeriodStartToSec: periodStartToSec},
+		shared.MetricsGetArgs{
+			Organization:       organization.FromContext(ctx),
+			PeriodStartFromSec: periodStartFromSec,
+			PeriodStartToSec:   periodStartToSec,
+		},
 		in.QueryFingerprint,
 		in.ClusterName,
 	)
@@ -182,6 +648,170 @@ func (aps *Service) GetQueryDetails(ctx context.Context, in *proto.GetQueryDetai
 	return &proto.GetQueryDetailsResponse{Traces: traces}, nil
 }
 
+func (aps *Service) GetSessionTimeline(ctx context.Context, in *proto.GetSessionTimelineRequest) (*proto.GetSessionTimelineResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+	if in.InstanceName == "" {
+		return nil, fmt.Errorf("instance_name is missing")
+	}
+	if in.Pid == 0 {
+		return nil, fmt.Errorf("pid is missing")
+	}
+
+	samples, truncated, err := aps.Repo.GetSessionSamples(ctx, QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+	}, in.InstanceName, in.Pid)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetSessionTimelineResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	if len(samples) == 0 {
+		return &proto.GetSessionTimelineResponse{}, nil
+	}
+
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	for _, sample := range samples {
+		aps.WaitEvents.addMissing(organization.FromContext(ctx), waitEvents, []string{sample.WaitEvent})
+	}
+
+	// the pid may have been reused by another session, the latest one is described
+	last := samples[len(samples)-1]
+	return &proto.GetSessionTimelineResponse{
+		Usename:         last.Usename,
+		ApplicationName: last.ApplicationName,
+		ClientHostname:  last.ClientHostname,
+		Datname:         last.Datname,
+		Intervals:       toSessionIntervals(samples, waitEvents),
+		Queries:         toSessionQueries(samples),
+		Truncated:       truncated,
+	}, nil
+}
+
+func (aps *Service) GetMaintenanceLoad(ctx context.Context, in *proto.GetMaintenanceLoadRequest) (*proto.GetMaintenanceLoadResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	filters, err := toDimensionFilters(in.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	args := QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+		Filters:            filters,
+	}
+	backendTypesLoad, err := aps.Repo.GetBackendTypesLoad(ctx, args)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetMaintenanceLoadResponse{}, fmt.Errorf("something went wrong")
+	}
+	maintenanceLoad, err := aps.Repo.GetMaintenanceLoad(ctx, args)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetMaintenanceLoadResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	response := &proto.GetMaintenanceLoadResponse{
+		BackendTypesAas: make(map[string]float32, len(backendTypesLoad)),
+		Tables:          toMaintenanceTables(maintenanceLoad),
+		WaitEvents:      make(map[string]*proto.WaitEvent),
+	}
+	for _, load := range backendTypesLoad {
+		response.BackendTypesAas[load.BackendType] = float32(load.AAS)
+	}
+	waitEvents := aps.getWaitEvents(ctx, in.ClusterName)
+	for _, table := range response.Tables {
+		aps.WaitEvents.addMissing(organization.FromContext(ctx), waitEvents, waitEventNames(table.AasWaitEvents))
+		for waitEventName := range table.AasWaitEvents {
+			response.WaitEvents[waitEventName] = waitEvents[waitEventName].toProto()
+		}
+	}
+
+	return response, nil
+}
+
+const (
+	defaultMinTransactionAge    = 5 * time.Minute
+	defaultMinIdleInTransaction = time.Minute
+)
+
+func (aps *Service) GetLongTransactions(ctx context.Context, in *proto.GetLongTransactionsRequest) (*proto.GetLongTransactionsResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	minTransactionAge := defaultMinTransactionAge
+	if in.MinTransactionAgeSecs > 0 {
+		minTransactionAge = time.Duration(in.MinTransactionAgeSecs) * time.Second
+	}
+	minIdleInTransaction := defaultMinIdleInTransaction
+	if in.MinIdleInTransactionSecs > 0 {
+		minIdleInTransaction = time.Duration(in.MinIdleInTransactionSecs) * time.Second
+	}
+
+	transactions, err := aps.Repo.GetLongTransactions(ctx, QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+	}, in.InstanceName, minTransactionAge, minIdleInTransaction)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetLongTransactionsResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	response := &proto.GetLongTransactionsResponse{Transactions: make([]*proto.LongTransaction, 0, len(transactions))}
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, transaction.ToProto())
+	}
+
+	return response, nil
+}
+
+func (aps *Service) GetLockTree(ctx context.Context, in *proto.GetLockTreeRequest) (*proto.GetLockTreeResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+
+	samples, err := aps.Repo.GetLockSamples(ctx, QueryArgs{
+		Organization:       organization.FromContext(ctx),
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		ClusterName:        in.ClusterName,
+	}, in.InstanceName)
+	if err != nil {
+		aps.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetLockTreeResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	return &proto.GetLockTreeResponse{Chains: toLockChains(samples)}, nil
+}
+
 func (aps *Service) getMetricsForTopQueries(ctx context.Context, args QueryArgs, queries []QueryDB) (
 	map[string]*proto.QueriesMetrics,
 	map[string]*proto.MetricValues,
@@ -190,6 +820,7 @@ func (aps *Service) getMetricsForTopQueries(ctx context.Context, args QueryArgs,
 	totalsList, err := aps.MetricsRepo.Get(
 		ctx,
 		shared.MetricsGetArgs{
+			Organization:       args.Organization,
 			PeriodStartFromSec: args.PeriodStartFromSec,
 			PeriodStartToSec:   args.PeriodStartToSec,
 			Totals:             true, // get Totals
@@ -211,6 +842,7 @@ func (aps *Service) getMetricsForTopQueries(ctx context.Context, args QueryArgs,
 	queriesMetrics := make(map[string]*proto.QueriesMetrics)
 	for _, query := range queries {
 		metricsList, err := aps.MetricsRepo.Get(ctx, shared.MetricsGetArgs{
+			Organization:       args.Organization,
 			PeriodStartFromSec: args.PeriodStartFromSec,
 			PeriodStartToSec:   args.PeriodStartToSec,
 			Filter:             query.Fingerprint,
@@ -252,18 +884,18 @@ func (aps *Service) getQueriesMetadata(ctx context.Context, queries []QueryDB, k
 // the output from the db is {'fingerprint': 'iend09030...', 'cpu_load_wait_events': {'transactionid':0.00008680555555555556,'tuple':0.00001736111111111111, ...}, ...}
 // we want to transform into {'transactionid': {'x_values_string': [...<query>], 'y_values_float': [...]}, ...}
 // output map[<wait_event_name>]{ x_value_string: ['SELECT * FROM table...', 'SELECT id FROM customers...'], y_values_float: [0.1, 0.0023, ...]}
-func (aps *Service) mapQueriesToTraces(queries []QueryDB, xValueGetter func(db QueryDB) string) map[string]*proto.Trace {
-	traces := aps.prefillTraces()
+// The wait events of the queries missing from the catalog are registered so that their load is not dropped.
+func (aps *Service) mapQueriesToTraces(org string, queries []QueryDB, xValueGetter func(db QueryDB) string, waitEvents map[string]WaitEvent) map[string]*proto.Trace {
+	for _, query := range queries {
+		aps.WaitEvents.addMissing(org, waitEvents, waitEventNames(query.CPULoadWaitEvents))
+	}
+
+	traces := aps.prefillTraces(waitEvents)
 	// This is done to remove all wait events that contains only zero values
 	hasWaitEventNonZeroValues := make(map[string]bool)
 
 	for _, query := range queries {
 		for waitEventName := range query.CPULoadWaitEvents {
-			if _, ok := traces[waitEventName]; !ok {
-				aps.log.Warningf("trace does not exist for wait event name: %v", waitEventName)
-				continue
-			}
-
 			hasWaitEventNonZeroValues[waitEventName] = true
 			trace := traces[waitEventName]
 			traceYValue := float32(query.CPULoadWaitEvents[waitEventName])
@@ -273,7 +905,7 @@ func (aps *Service) mapQueriesToTraces(queries []QueryDB, xValueGetter func(db Q
 			traces[waitEventName] = trace
 		}
 
-		for waitEventName := range aps.WaitEventsMap {
+		for waitEventName := range waitEvents {
 			if _, ok := query.CPULoadWaitEvents[waitEventName]; ok {
 				continue
 			}
@@ -296,7 +928,27 @@ func (aps *Service) mapQueriesToTraces(queries []QueryDB, xValueGetter func(db Q
 	return traces
 }
 
-// This function output time slots, each slot correspond to 1 minute of aggregated wait events count,
+// mapQueriesToGroupsTraces returns a trace for each value of the group_by dimension,
+// with the load of every query for that value, 0 if the query did not run with it.
+func (aps *Service) mapQueriesToGroupsTraces(queries []QueryDB, xValueGetter func(db QueryDB) string) map[string]*proto.Trace {
+	traces := make(map[string]*proto.Trace)
+	for _, query := range queries {
+		for groupValue := range query.CPULoadGroups {
+			traces[groupValue] = &proto.Trace{}
+		}
+	}
+
+	for _, query := range queries {
+		for groupValue, trace := range traces {
+			trace.XValuesString = append(trace.XValuesString, xValueGetter(query))
+			trace.YValuesFloat = append(trace.YValuesFloat, float32(query.CPULoadGroups[groupValue]))
+		}
+	}
+
+	return traces
+}
+
+// This function output time slots, each slot holds the average active sessions of its wait events over the step,
 // Since we return a map the order is not guaranteed, thus this method will also return
 // an ordered (ASC) unique timestamps array to use it later.
 func (aps *Service) getSlots(results []SlotDB) (Slots, []time.Time) {
@@ -308,24 +960,43 @@ func (aps *Service) getSlots(results []SlotDB) (Slots, []time.Time) {
 			ascOrderedUniqueTimestamps = append(ascOrderedUniqueTimestamps, slotDB.Timestamp)
 			timestampsMap[slotDB.Timestamp] = true
 		}
+		// results grouped by a dimension contain the same wait event several times in a slot
 		if slot, ok := slots[slotDB.Timestamp]; ok {
-			slot[slotDB.WaitEventName] = float32(slotDB.WaitEventCount)
+			slot[slotDB.WaitEventName] += slotDB.GetAAS()
 			slots[slotDB.Timestamp] = slot
 		} else {
 			slot := make(Slot)
-			slot[slotDB.WaitEventName] = float32(slotDB.WaitEventCount)
+			slot[slotDB.WaitEventName] = slotDB.GetAAS()
 			slots[slotDB.Timestamp] = slot
 		}
 	}
 	return slots, ascOrderedUniqueTimestamps
 }
 
+// mapGroupsToProfiles splits the results by the value of the group_by dimension and returns the traces of each value,
+// all of them use the timestamps of the whole profile so that they can be compared.
+func (aps *Service) mapGroupsToProfiles(results []SlotDB, ascOrderedTimeStamps []time.Time, view profileView) map[string]*proto.GroupProfile {
+	resultsByGroup := make(map[string][]SlotDB)
+	for _, slotDB := range results {
+		resultsByGroup[slotDB.GroupValue] = append(resultsByGroup[slotDB.GroupValue], slotDB)
+	}
+
+	groups := make(map[string]*proto.GroupProfile)
+	for groupValue, groupResults := range resultsByGroup {
+		slots, _ := aps.getSlots(groupResults)
+		groups[groupValue] = &proto.GroupProfile{Traces: aps.mapSlotsToTraces(view.aggregate(slots), ascOrderedTimeStamps, view.waitEvents)}
+	}
+
+	return groups
+}
+
 // This method will format data for Plotly:
 // https://plotly.com/javascript/reference/index/
 // it will use the previously created unique ordered timestamps to maintain the sorting.
 // If a wait event is missing in the current timestamp we will assign 0 to its value.
-func (aps *Service) mapSlotsToTraces(slots Slots, ascOrderedTimeStamps []time.Time) map[string]*proto.Trace {
-	traces := aps.prefillTraces()
+// The traces are the wait events (or the classes) of waitEvents.
+func (aps *Service) mapSlotsToTraces(slots Slots, ascOrderedTimeStamps []time.Time, waitEvents map[string]WaitEvent) map[string]*proto.Trace {
+	traces := aps.prefillTraces(waitEvents)
 	hasWaitEventNonZeroValues := make(map[string]bool)
 
 	for _, timestamp := range ascOrderedTimeStamps {
@@ -344,7 +1015,7 @@ func (aps *Service) mapSlotsToTraces(slots Slots, ascOrderedTimeStamps []time.Ti
 			traces[waitEventName] = trace
 		}
 
-		for waitEventName := range aps.WaitEventsMap {
+		for waitEventName := range waitEvents {
 			if _, ok := slot[waitEventName]; ok {
 				continue
 			}
@@ -367,9 +1038,9 @@ func (aps *Service) mapSlotsToTraces(slots Slots, ascOrderedTimeStamps []time.Ti
 }
 
 // To avoid empty or missing traces (for example a missing wait event for the aggregated minute), we prefill traces
-func (aps *Service) prefillTraces() map[string]*proto.Trace {
+func (aps *Service) prefillTraces(waitEvents map[string]WaitEvent) map[string]*proto.Trace {
 	traces := make(map[string]*proto.Trace)
-	for waitEventName, val := range aps.WaitEventsMap {
+	for waitEventName, val := range waitEvents {
 		timestamps := make([]*timestamppb.Timestamp, 0)
 		floats := make([]float32, 0)
 		strings := make([]string, 0)
@@ -1,13 +1,16 @@
 package activities
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
 	"testing"
+	"time"
 )
 
 // We replicate this type because we cannot unmarshal sql.NullString
@@ -50,7 +53,7 @@ func loadFixtures(t *testing.T) []QueryDB {
 func TestService_mapQueriesToPlotlyTraces(t *testing.T) {
 	type fields struct {
 		Repo                     Repository
-		WaitEventsMap            map[string]WaitEvent
+		WaitEvents               *WaitEventCatalog
 		log                      *logrus.Entry
 		ActivitiesProfilerServer proto.ActivitiesServer
 	}
@@ -67,7 +70,7 @@ func TestService_mapQueriesToPlotlyTraces(t *testing.T) {
 			name: "map queries to trace",
 			fields: fields{
 				Repo:                     Repository{},
-				WaitEventsMap:            LoadWaitEventsMapFromFile("./"),
+				WaitEvents:               loadCatalog(t),
 				log:                      &logrus.Entry{Logger: logrus.New()},
 				ActivitiesProfilerServer: nil,
 			},
@@ -79,15 +82,170 @@ func TestService_mapQueriesToPlotlyTraces(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			aps := &Service{
 				Repo:             tt.fields.Repo,
-				WaitEventsMap:    tt.fields.WaitEventsMap,
+				WaitEvents:       tt.fields.WaitEvents,
 				log:              tt.fields.log,
 				ActivitiesServer: tt.fields.ActivitiesProfilerServer,
 			}
-			res := aps.mapQueriesToTraces(tt.args.queries, func(db QueryDB) string {
+			res := aps.mapQueriesToTraces("org", tt.args.queries, func(db QueryDB) string {
 				return db.Fingerprint
-			})
+			}, aps.WaitEvents.ForServerVersion("org", 0))
 
-			assert.Equal(t, len(res), 13)
+			assert.Equal(t, len(res), 14)
 		})
 	}
 }
+
+func TestService_mapQueriesToGroupsTraces(t *testing.T) {
+	aps := &Service{log: &logrus.Entry{Logger: logrus.New()}}
+	queries := []QueryDB{
+		{Fingerprint: "a", CPULoadGroups: map[string]float64{"app1": 0.5, "app2": 0.25}},
+		{Fingerprint: "b", CPULoadGroups: map[string]float64{"app2": 1}},
+	}
+
+	res := aps.mapQueriesToGroupsTraces(queries, func(db QueryDB) string {
+		return db.Fingerprint
+	})
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, []string{"a", "b"}, res["app1"].XValuesString)
+	assert.Equal(t, []float32{0.5, 0}, res["app1"].YValuesFloat)
+	assert.Equal(t, []float32{0.25, 1}, res["app2"].YValuesFloat)
+}
+
+func TestService_mapGroupsToProfiles(t *testing.T) {
+	aps := &Service{log: &logrus.Entry{Logger: logrus.New()}}
+	waitEvents := map[string]WaitEvent{"WALWrite": {Color: "red"}, "CPU": {Color: "green"}}
+	first := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	results := []SlotDB{
+		{Timestamp: first, WaitEventName: "WALWrite", SlotLength: 60, DBTimeSecs: 60, GroupValue: "app1"},
+		{Timestamp: first, WaitEventName: "WALWrite", SlotLength: 60, DBTimeSecs: 120, GroupValue: "app2"},
+		{Timestamp: second, WaitEventName: "CPU", SlotLength: 60, DBTimeSecs: 60, GroupValue: "app2"},
+	}
+
+	slots, timestamps := aps.getSlots(results)
+	assert.Equal(t, []time.Time{first, second}, timestamps)
+	assert.Equal(t, float32(3), slots[first]["WALWrite"])
+
+	view, err := newProfileView(waitEvents, false, "")
+	assert.NoError(t, err)
+	groups := aps.mapGroupsToProfiles(results, timestamps, view)
+	assert.Len(t, groups, 2)
+	assert.Len(t, groups["app1"].Traces, 1)
+	assert.Equal(t, []float32{1, 0}, groups["app1"].Traces["WALWrite"].YValuesFloat)
+	assert.Len(t, groups["app2"].Traces, 2)
+	assert.Equal(t, []float32{2, 0}, groups["app2"].Traces["WALWrite"].YValuesFloat)
+	assert.Equal(t, []float32{0, 1}, groups["app2"].Traces["CPU"].YValuesFloat)
+}
+
+func TestGetRequestGroup(t *testing.T) {
+	group, err := getRequestGroup("")
+	assert.NoError(t, err)
+	assert.Equal(t, Group{}, group)
+
+	group, err = getRequestGroup("application_name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Application", group.Name)
+
+	_, err = getRequestGroup("query")
+	assert.Error(t, err)
+}
+
+func TestGetRequestStep(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "auto", step: "auto", want: 10 * time.Second},
+		{name: "empty", step: "", want: 10 * time.Second},
+		{name: "seconds", step: "1s", want: time.Second},
+		{name: "minutes", step: "5m", want: 5 * time.Minute},
+		{name: "hour", step: "1h", want: time.Hour},
+		{name: "too fine", step: "500ms", wantErr: true},
+		{name: "too coarse", step: "2h", wantErr: true},
+		{name: "not whole seconds", step: "1.5s", wantErr: true},
+		{name: "invalid", step: "minute", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := getRequestStep(tt.step, 0, 3600)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, step)
+		})
+	}
+
+	// too many slots over the range
+	_, err := getRequestStep("1s", 0, 2*3600)
+	assert.Error(t, err)
+	_, err = getRequestStep("auto", 0, 365*24*3600)
+	assert.Error(t, err)
+}
+
+func TestSlotDB_GetAAS(t *testing.T) {
+	assert.Equal(t, float32(2), SlotDB{SlotLength: 10, DBTimeSecs: 20}.GetAAS())
+	assert.Equal(t, float32(0.5), SlotDB{SlotLength: 3600, DBTimeSecs: 1800}.GetAAS())
+	assert.Equal(t, float32(0), SlotDB{DBTimeSecs: 20}.GetAAS())
+}
+
+func TestClientDB_ToProto(t *testing.T) {
+	client := ClientDB{
+		ClientHostname:  "10.0.0.1",
+		ApplicationName: "billing",
+		Usename:         "app",
+		AASWaitEvents:   map[string]float64{"CPU": 1.5, "WALWrite": 0.5},
+		AASTotal:        2,
+		CPULoadTotal:    0.25,
+	}.ToProto()
+
+	assert.Equal(t, float32(2), client.Aas)
+	assert.Equal(t, map[string]float32{"CPU": 1.5, "WALWrite": 0.5}, client.AasWaitEvents)
+
+	// the filters of the client select its top queries
+	filters, err := toDimensionFilters(client.Filters)
+	assert.NoError(t, err)
+	assert.Equal(t, []DimensionFilter{
+		{Column: "client_hostname", Values: []string{"10.0.0.1"}},
+		{Column: "application_name", Values: []string{"billing"}},
+		{Column: "usename", Values: []string{"app"}},
+	}, filters)
+}
+
+func TestToDimensionFilters(t *testing.T) {
+	filters, err := toDimensionFilters([]*proto.DimensionFilter{
+		{Dimension: "user", Values: []string{"etl"}, Exclude: true},
+		{Dimension: "database", Values: []string{"shop", "billing"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []DimensionFilter{
+		{Column: "usename", Values: []string{"etl"}, Exclude: true},
+		{Column: "datname", Values: []string{"shop", "billing"}},
+	}, filters)
+
+	_, err = toDimensionFilters([]*proto.DimensionFilter{{Dimension: "query", Values: []string{"SELECT 1"}}})
+	assert.Error(t, err)
+
+	_, err = toDimensionFilters([]*proto.DimensionFilter{{Dimension: "user"}})
+	assert.Error(t, err)
+}
+
+func TestService_GetIngestionStats(t *testing.T) {
+	sampler := newTestSampler(1, QueuePolicyReject)
+	assert.NoError(t, sampler.Save(context.Background(), "org", collectRequestOf(1)))
+	assert.Error(t, sampler.Save(context.Background(), "org", collectRequestOf(3)))
+
+	aps := &Service{Sampler: sampler, log: &logrus.Entry{Logger: logrus.New()}}
+	res, err := aps.GetIngestionStats(organization.NewContext(context.Background(), "org"), &proto.GetIngestionStatsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, &proto.GetIngestionStatsResponse{DroppedSamples: 3, QueuedRequests: 1, QueueSize: 1, QueuePolicy: "reject"}, res)
+
+	// the counters of the other organizations are not returned
+	res, err = aps.GetIngestionStats(organization.NewContext(context.Background(), "other"), &proto.GetIngestionStatsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, &proto.GetIngestionStatsResponse{QueueSize: 1, QueuePolicy: "reject"}, res)
+}
@@ -0,0 +1,131 @@
+package activities
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/proto"
+	"time"
+)
+
+// SessionSampleDB is a sample of a single backend, the samples of a session are ordered by time
+type SessionSampleDB struct {
+	CurrentTimestamp time.Time `json:"current_timestamp"`
+	PeriodLength     uint32    `json:"period_length"`
+	State            string    `json:"state"`
+	WaitEventType    string    `json:"wait_event_type"`
+	WaitEvent        string    `json:"wait_event"`
+	QueryStart       uint32    `json:"query_start"`
+	BackendStart     uint32    `json:"backend_start"`
+	Fingerprint      string    `json:"fingerprint"`
+	QuerySha         string    `json:"query_sha"`
+	Query            string    `json:"query"`
+	Duration         float32   `json:"duration"`
+	Usename          string    `json:"usename"`
+	ApplicationName  string    `json:"application_name"`
+	ClientHostname   string    `json:"client_hostname"`
+	Datname          string    `json:"datname"`
+}
+
+// end is the time until which the sample describes the session
+func (s SessionSampleDB) end() time.Time {
+	periodLength := time.Duration(s.PeriodLength) * time.Second
+	if periodLength == 0 {
+		periodLength = time.Second
+	}
+
+	return s.CurrentTimestamp.Add(periodLength)
+}
+
+// sameSession returns whether two samples of a pid belong to the same session,
+// the pid is reused by the next backend once a session ends.
+func (s SessionSampleDB) sameSession(other SessionSampleDB) bool {
+	return s.BackendStart == other.BackendStart &&
+		s.Usename == other.Usename &&
+		s.ApplicationName == other.ApplicationName
+}
+
+// latestSessionSamples orders by time the samples selected newest first, keeping at most maxSessionSamples
+// of them, and returns whether older samples were left out.
+func latestSessionSamples(samples []SessionSampleDB) ([]SessionSampleDB, bool) {
+	truncated := len(samples) > maxSessionSamples
+	if truncated {
+		samples = samples[:maxSessionSamples]
+	}
+
+	for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+		samples[i], samples[j] = samples[j], samples[i]
+	}
+
+	return samples, truncated
+}
+
+// toSessionIntervals merges the consecutive samples of a session in the same state and wait event into intervals,
+// a missing sample or a new session on the same pid ends the interval.
+func toSessionIntervals(samples []SessionSampleDB, waitEvents map[string]WaitEvent) []*proto.SessionInterval {
+	intervals := make([]*proto.SessionInterval, 0)
+
+	var current *proto.SessionInterval
+	var currentSample SessionSampleDB
+	var currentEnd time.Time
+	for _, sample := range samples {
+		if current != nil &&
+			!sample.CurrentTimestamp.After(currentEnd) &&
+			sample.sameSession(currentSample) &&
+			current.State == sample.State &&
+			current.WaitEventType == sample.WaitEventType &&
+			current.WaitEvent == sample.WaitEvent {
+			currentEnd = sample.end()
+			current.End = timestamppb.New(currentEnd)
+			continue
+		}
+
+		currentSample = sample
+		currentEnd = sample.end()
+		current = &proto.SessionInterval{
+			Start:         timestamppb.New(sample.CurrentTimestamp),
+			End:           timestamppb.New(currentEnd),
+			State:         sample.State,
+			WaitEventType: sample.WaitEventType,
+			WaitEvent:     sample.WaitEvent,
+			Color:         waitEvents[sample.WaitEvent].Color,
+		}
+		intervals = append(intervals, current)
+	}
+
+	return intervals
+}
+
+// toSessionQueries returns the queries run by the session, a query is identified by its start and its text
+func toSessionQueries(samples []SessionSampleDB) []*proto.SessionQuery {
+	queries := make([]*proto.SessionQuery, 0)
+
+	var current *proto.SessionQuery
+	var currentStart uint32
+	for _, sample := range samples {
+		if sample.Query == "" {
+			continue
+		}
+
+		if current != nil && currentStart == sample.QueryStart && current.Query == sample.Query {
+			current.LastSeen = timestamppb.New(sample.CurrentTimestamp)
+			current.Samples++
+			if sample.Duration > current.Duration {
+				current.Duration = sample.Duration
+			}
+			continue
+		}
+
+		currentStart = sample.QueryStart
+		current = &proto.SessionQuery{
+			QueryStart:  timestamppb.New(time.Unix(int64(sample.QueryStart), 0).UTC()),
+			LastSeen:    timestamppb.New(sample.CurrentTimestamp),
+			Fingerprint: sample.Fingerprint,
+			QuerySha:    sample.QuerySha,
+			Query:       sample.Query,
+			Duration:    sample.Duration,
+			Samples:     1,
+		}
+		queries = append(queries, current)
+	}
+
+	return queries
+}
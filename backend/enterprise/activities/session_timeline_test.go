@@ -0,0 +1,92 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestToSessionIntervals(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	samples := []SessionSampleDB{
+		{CurrentTimestamp: start, PeriodLength: 1, State: "active", WaitEvent: "CPU"},
+		{CurrentTimestamp: start.Add(time.Second), PeriodLength: 1, State: "active", WaitEvent: "CPU"},
+		{CurrentTimestamp: start.Add(2 * time.Second), PeriodLength: 1, State: "active", WaitEventType: "IO", WaitEvent: "DataFileRead"},
+		{CurrentTimestamp: start.Add(3 * time.Second), PeriodLength: 1, State: "idle", WaitEventType: "Client", WaitEvent: "ClientRead"},
+		// the session was not sampled for a while
+		{CurrentTimestamp: start.Add(10 * time.Second), PeriodLength: 1, State: "idle", WaitEventType: "Client", WaitEvent: "ClientRead"},
+	}
+
+	intervals := toSessionIntervals(samples, map[string]WaitEvent{"CPU": {Color: "green"}})
+	assert.Len(t, intervals, 4)
+	assert.Equal(t, start, intervals[0].Start.AsTime())
+	assert.Equal(t, start.Add(2*time.Second), intervals[0].End.AsTime())
+	assert.Equal(t, "green", intervals[0].Color)
+	assert.Equal(t, "DataFileRead", intervals[1].WaitEvent)
+	assert.Equal(t, start.Add(4*time.Second), intervals[2].End.AsTime())
+	assert.Equal(t, start.Add(10*time.Second), intervals[3].Start.AsTime())
+}
+
+func TestToSessionIntervals_NewSession(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	backendStart := uint32(start.Unix())
+	samples := []SessionSampleDB{
+		{CurrentTimestamp: start, PeriodLength: 1, BackendStart: backendStart, Usename: "app", State: "idle", WaitEvent: "ClientRead"},
+		// the pid was reused right away by a new backend
+		{CurrentTimestamp: start.Add(time.Second), PeriodLength: 1, BackendStart: backendStart + 1, Usename: "app", State: "idle", WaitEvent: "ClientRead"},
+		{CurrentTimestamp: start.Add(2 * time.Second), PeriodLength: 1, BackendStart: backendStart + 1, Usename: "app", State: "idle", WaitEvent: "ClientRead"},
+		// the application name changed
+		{CurrentTimestamp: start.Add(3 * time.Second), PeriodLength: 1, BackendStart: backendStart + 1, Usename: "app", ApplicationName: "psql", State: "idle", WaitEvent: "ClientRead"},
+	}
+
+	intervals := toSessionIntervals(samples, map[string]WaitEvent{})
+	assert.Len(t, intervals, 3)
+	assert.Equal(t, start.Add(time.Second), intervals[0].End.AsTime())
+	assert.Equal(t, start.Add(time.Second), intervals[1].Start.AsTime())
+	assert.Equal(t, start.Add(3*time.Second), intervals[1].End.AsTime())
+	assert.Equal(t, start.Add(3*time.Second), intervals[2].Start.AsTime())
+}
+
+func TestLatestSessionSamples(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	newestFirst := func(n int) []SessionSampleDB {
+		samples := make([]SessionSampleDB, n)
+		for i := range samples {
+			samples[i].CurrentTimestamp = start.Add(time.Duration(n-i) * time.Second)
+		}
+		return samples
+	}
+
+	samples, truncated := latestSessionSamples(newestFirst(3))
+	assert.False(t, truncated)
+	assert.Len(t, samples, 3)
+	assert.Equal(t, start.Add(time.Second), samples[0].CurrentTimestamp)
+	assert.Equal(t, start.Add(3*time.Second), samples[2].CurrentTimestamp)
+
+	samples, truncated = latestSessionSamples(newestFirst(maxSessionSamples + 1))
+	assert.True(t, truncated)
+	assert.Len(t, samples, maxSessionSamples)
+	// the oldest sample is the one left out
+	assert.Equal(t, start.Add(2*time.Second), samples[0].CurrentTimestamp)
+	assert.Equal(t, start.Add((maxSessionSamples+1)*time.Second), samples[maxSessionSamples-1].CurrentTimestamp)
+}
+
+func TestToSessionQueries(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	queryStart := uint32(start.Unix())
+	samples := []SessionSampleDB{
+		{CurrentTimestamp: start, QueryStart: queryStart, Query: "SELECT 1", Duration: 0.5},
+		{CurrentTimestamp: start.Add(time.Second), QueryStart: queryStart, Query: "SELECT 1", Duration: 1.5},
+		{CurrentTimestamp: start.Add(2 * time.Second)},
+		// the same query run again
+		{CurrentTimestamp: start.Add(3 * time.Second), QueryStart: queryStart + 3, Query: "SELECT 1", Duration: 0.1},
+	}
+
+	queries := toSessionQueries(samples)
+	assert.Len(t, queries, 2)
+	assert.Equal(t, start, queries[0].QueryStart.AsTime())
+	assert.Equal(t, start.Add(time.Second), queries[0].LastSeen.AsTime())
+	assert.Equal(t, float32(1.5), queries[0].Duration)
+	assert.Equal(t, uint32(2), queries[0].Samples)
+	assert.Equal(t, uint32(1), queries[1].Samples)
+}
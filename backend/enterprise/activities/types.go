@@ -2,7 +2,11 @@ package activities
 
 import (
 	"database/sql"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"postgres-explain/proto"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -21,6 +25,10 @@ type Group struct {
 }
 type waitEventsGroup map[string]Group
 
+func (g Group) ToProto() *proto.Group {
+	return &proto.Group{Id: g.ID, Name: g.Name}
+}
+
 var waitEventsGroupsMap = waitEventsGroup{
 	"application_name": Group{
 		ID:   "application_name",
@@ -38,6 +46,71 @@ var waitEventsGroupsMap = waitEventsGroup{
 		ID:   "instance_name",
 		Name: "Instance",
 	},
+	"backend_type": Group{
+		ID:   "backend_type",
+		Name: "Backend type",
+	},
+}
+
+// getGroup returns the group with the given id, the id is used as column name thus it must be validated with this function
+func (w waitEventsGroup) getGroup(id string) (Group, error) {
+	group, ok := w[id]
+	if !ok {
+		ids := make([]string, 0, len(w))
+		for groupID := range w {
+			ids = append(ids, groupID)
+		}
+		sort.Strings(ids)
+		return Group{}, fmt.Errorf("group_by %v is not valid, must be one of: %v", id, strings.Join(ids, ", "))
+	}
+
+	return group, nil
+}
+
+// filterDimensions maps the dimensions of the filters to the columns of the activities
+var filterDimensions = map[string]string{
+	"database":         "datname",
+	"user":             "usename",
+	"application_name": "application_name",
+	"client_hostname":  "client_hostname",
+	"instance_name":    "instance_name",
+	"backend_type":     "backend_type",
+	"state":            "state",
+	"wait_event_type":  "wait_event_type",
+}
+
+// DimensionFilter keeps the activities whose Column has one of the Values, or removes them if Exclude is set
+type DimensionFilter struct {
+	Column  string
+	Values  []string
+	Exclude bool
+}
+
+// toDimensionFilters validates the filters of a request, the columns come from filterDimensions only
+func toDimensionFilters(filters []*proto.DimensionFilter) ([]DimensionFilter, error) {
+	dimensionFilters := make([]DimensionFilter, 0, len(filters))
+	for _, filter := range filters {
+		column, ok := filterDimensions[filter.Dimension]
+		if !ok {
+			dimensions := make([]string, 0, len(filterDimensions))
+			for dimension := range filterDimensions {
+				dimensions = append(dimensions, dimension)
+			}
+			sort.Strings(dimensions)
+			return nil, fmt.Errorf("filter dimension %v is not valid, must be one of: %v", filter.Dimension, strings.Join(dimensions, ", "))
+		}
+		if len(filter.Values) == 0 {
+			return nil, fmt.Errorf("filter on %v has no values", filter.Dimension)
+		}
+
+		dimensionFilters = append(dimensionFilters, DimensionFilter{
+			Column:  column,
+			Values:  filter.Values,
+			Exclude: filter.Exclude,
+		})
+	}
+
+	return dimensionFilters, nil
 }
 
 type QueryMetadataRequest struct {
@@ -53,23 +126,38 @@ type QueryMetadata struct {
 }
 
 type QueryArgs struct {
+	Organization       string
 	PeriodStartFromSec int64
 	PeriodStartToSec   int64
 	ClusterName        string
 	Fingerprint        string
+	// GroupBy is a column of waitEventsGroupsMap, empty to not group
+	GroupBy string
+	Filters []DimensionFilter
+	// Step is the width of the slots of the profile
+	Step time.Duration
+	// Limit and Offset page the top queries ordered by the Ranking
+	Limit   uint32
+	Offset  uint32
+	Ranking Ranking
 }
 
 type SlotDB struct {
 	Timestamp      time.Time `json:"slot"`
+	SlotLength     uint32    `json:"slot_length"`
 	WaitEventCount int       `json:"wait_event_count"`
+	DBTimeSecs     uint64    `json:"db_time_secs"`
 	WaitEventName  string    `json:"wait_event"`
 	CpuCores       float32   `json:"cpu_cores"`
+	GroupValue     string    `json:"group_value"`
 }
 
 type QueryDB struct {
 	Fingerprint           string             `json:"fingerprint"`
 	CPULoadWaitEvents     map[string]float64 `json:"cpu_load_wait_events"`
 	CPULoadTotal          float32            `json:"cpu_load_total"`
+	CPULoadGroups         map[string]float64 `json:"cpu_load_groups"`
+	RankValue             float64            `json:"rank_value"`
 	ParsedQuery           sql.NullString     `json:"parsed_query"`
 	Query                 string             `json:"query"`
 	QuerySha              string             `json:"query_sha"`
@@ -91,20 +179,107 @@ type QueryByFingerprintDB struct {
 	Query             string             `json:"query"`
 }
 
+type ClientDB struct {
+	ClientHostname  string             `json:"client_hostname"`
+	ApplicationName string             `json:"application_name"`
+	Usename         string             `json:"usename"`
+	AASWaitEvents   map[string]float64 `json:"aas_wait_events"`
+	AASTotal        float32            `json:"aas_total"`
+	CPULoadTotal    float32            `json:"cpu_load_total"`
+}
+
+// ToProto returns the client with the filters of its top queries
+func (c ClientDB) ToProto() *proto.Client {
+	aasWaitEvents := make(map[string]float32, len(c.AASWaitEvents))
+	for waitEvent, aas := range c.AASWaitEvents {
+		aasWaitEvents[waitEvent] = float32(aas)
+	}
+
+	return &proto.Client{
+		ClientHostname:  c.ClientHostname,
+		ApplicationName: c.ApplicationName,
+		Usename:         c.Usename,
+		Aas:             c.AASTotal,
+		AasWaitEvents:   aasWaitEvents,
+		CpuLoadTotal:    c.CPULoadTotal,
+		Filters: []*proto.DimensionFilter{
+			{Dimension: "client_hostname", Values: []string{c.ClientHostname}},
+			{Dimension: "application_name", Values: []string{c.ApplicationName}},
+			{Dimension: "user", Values: []string{c.Usename}},
+		},
+	}
+}
+
+type LongTransactionDB struct {
+	InstanceName          string    `json:"instance_name"`
+	Pid                   uint32    `json:"pid"`
+	XactStart             uint32    `json:"xact_start"`
+	LastSeen              time.Time `json:"last_seen"`
+	TransactionAgeSecs    int64     `json:"transaction_age_secs"`
+	IdleInTransactionSecs int64     `json:"idle_in_transaction_secs"`
+	BackendXmin           uint32    `json:"backend_xmin"`
+	Datname               string    `json:"datname"`
+	Usename               string    `json:"usename"`
+	ApplicationName       string    `json:"application_name"`
+	ClientHostname        string    `json:"client_hostname"`
+	State                 string    `json:"state"`
+	Fingerprint           string    `json:"fingerprint"`
+	Query                 string    `json:"query"`
+}
+
+func (t LongTransactionDB) ToProto() *proto.LongTransaction {
+	return &proto.LongTransaction{
+		InstanceName:          t.InstanceName,
+		Pid:                   t.Pid,
+		Datname:               t.Datname,
+		Usename:               t.Usename,
+		ApplicationName:       t.ApplicationName,
+		ClientHostname:        t.ClientHostname,
+		XactStart:             timestamppb.New(time.Unix(int64(t.XactStart), 0).UTC()),
+		LastSeen:              timestamppb.New(t.LastSeen),
+		TransactionAgeSecs:    nonNegativeSecs(t.TransactionAgeSecs),
+		IdleInTransactionSecs: nonNegativeSecs(t.IdleInTransactionSecs),
+		BackendXmin:           t.BackendXmin,
+		State:                 t.State,
+		Fingerprint:           t.Fingerprint,
+		Query:                 t.Query,
+	}
+}
+
+// nonNegativeSecs converts an age to seconds, the negative ages caused by a clock skew between the collector
+// and the database are reported as 0
+func nonNegativeSecs(secs int64) uint32 {
+	if secs < 0 {
+		return 0
+	}
+	return uint32(secs)
+}
+
 type ActivitySampleDB struct {
+	Organization     string    `json:"organization"`
 	PeriodStart      time.Time `json:"period_start"`
 	PeriodLength     uint32    `json:"period_length"`
 	CurrentTimestamp time.Time `json:"current_timestamp"`
 	IsQueryTruncated uint8     `json:"is_query_truncated"`
+	BatchID          string    `json:"batch_id"`
 	*proto.ActivitySample
 }
 
-func (s *ActivitySampleDB) FromActivitySample(sample *proto.ActivitySample) {
+func (s *ActivitySampleDB) GetOrganization() string {
+	return s.Organization
+}
+
+func (s *ActivitySampleDB) SetOrganization(organization string) {
+	s.Organization = organization
+}
+
+func (s *ActivitySampleDB) FromActivitySample(organization string, sample *proto.ActivitySample) {
 	isTruncated := 0
 	if sample.IsQueryTruncated {
 		isTruncated = 1
 	}
 
+	s.Organization = organization
 	s.PeriodStart = time.Unix(int64(sample.GetPeriodStartUnixSecs()), 0).UTC()
 	s.PeriodLength = sample.GetPeriodLengthSecs()
 	s.CurrentTimestamp = time.Unix(int64(sample.GetCurrentTimestamp()), 0).UTC()
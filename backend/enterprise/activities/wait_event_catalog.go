@@ -0,0 +1,277 @@
+package activities
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"postgres-explain/proto"
+	"sync"
+)
+
+const (
+	// minCatalogVersion and maxCatalogVersion are the major versions of PostgreSQL with a catalog,
+	// older versions use the oldest catalog and newer or unknown versions the latest one
+	minCatalogVersion = 12
+	maxCatalogVersion = 17
+
+	// customWaitEventClass is the class of the custom wait events without a valid class, extensions report them as Extension
+	customWaitEventClass = "Extension"
+
+	// maxRegisteredWaitEvents bounds the wait events registered for an organization, the next ones are shown
+	// with a generated color but not remembered
+	maxRegisteredWaitEvents = 500
+)
+
+// WaitEventNameDB is a wait event reported by the samples
+type WaitEventNameDB struct {
+	WaitEventType string `json:"wait_event_type"`
+	WaitEvent     string `json:"wait_event"`
+}
+
+// WaitEventCatalog holds the wait events of every major version of PostgreSQL,
+// the wait events reported by the collectors of an organization that are missing from the catalogs
+// are registered for that organization when first seen.
+type WaitEventCatalog struct {
+	versions map[uint32]map[string]WaitEvent
+
+	mu sync.RWMutex
+	// registered are the wait events missing from the catalogs by organization, they are added to every version
+	registered map[string]map[string]WaitEvent
+	// loaded are the organizations whose recent wait events were registered again since the start
+	loaded map[string]bool
+}
+
+// NewWaitEventCatalog splits the wait events by the versions reporting them, the custom wait events are added to every version
+func NewWaitEventCatalog(waitEvents, customWaitEvents map[string]WaitEvent) *WaitEventCatalog {
+	catalog := &WaitEventCatalog{
+		versions:   make(map[uint32]map[string]WaitEvent, maxCatalogVersion-minCatalogVersion+1),
+		registered: make(map[string]map[string]WaitEvent),
+		loaded:     make(map[string]bool),
+	}
+
+	for version := uint32(minCatalogVersion); version <= maxCatalogVersion; version++ {
+		versionWaitEvents := make(map[string]WaitEvent)
+		for name, waitEvent := range waitEvents {
+			if waitEvent.MinVersion != 0 && version < waitEvent.MinVersion {
+				continue
+			}
+			if waitEvent.MaxVersion != 0 && version > waitEvent.MaxVersion {
+				continue
+			}
+			versionWaitEvents[name] = withDefaults(name, waitEvent)
+		}
+		for name, waitEvent := range customWaitEvents {
+			if _, ok := waitEventClasses[waitEvent.Class]; !ok {
+				waitEvent.Class = customWaitEventClass
+			}
+			versionWaitEvents[name] = withDefaults(name, waitEvent)
+		}
+		catalog.versions[version] = versionWaitEvents
+	}
+
+	return catalog
+}
+
+func withDefaults(name string, waitEvent WaitEvent) WaitEvent {
+	if waitEvent.Type == "" {
+		waitEvent.Type = name
+	}
+	if waitEvent.Color == "" {
+		waitEvent.Color = generateColor(name)
+	}
+
+	return waitEvent
+}
+
+// majorVersion returns the major version of the catalog of a server_version_num, such as 150004
+func majorVersion(serverVersionNum uint32) uint32 {
+	major := serverVersionNum / 10000
+	switch {
+	case serverVersionNum == 0 || major > maxCatalogVersion:
+		return maxCatalogVersion
+	case major < minCatalogVersion:
+		return minCatalogVersion
+	default:
+		return major
+	}
+}
+
+// ForServerVersion returns a copy of the wait events of the version of a server with the wait events registered
+// for the organization, the latest version is used when the version is unknown
+func (c *WaitEventCatalog) ForServerVersion(organization string, serverVersionNum uint32) map[string]WaitEvent {
+	versionWaitEvents := c.versions[majorVersion(serverVersionNum)]
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	registered := c.registered[organization]
+	waitEvents := make(map[string]WaitEvent, len(versionWaitEvents)+len(registered))
+	for name, waitEvent := range versionWaitEvents {
+		waitEvents[name] = waitEvent
+	}
+	for name, waitEvent := range registered {
+		if _, ok := waitEvents[name]; !ok {
+			waitEvents[name] = waitEvent
+		}
+	}
+
+	return waitEvents
+}
+
+// LoadOnce registers the wait events returned by load the first time it is called for an organization,
+// load is called again on the next call when it fails
+func (c *WaitEventCatalog) LoadOnce(organization string, load func() ([]WaitEventNameDB, error)) error {
+	c.mu.RLock()
+	loaded := c.loaded[organization]
+	c.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	waitEvents, err := load()
+	if err != nil {
+		return err
+	}
+	for _, waitEvent := range waitEvents {
+		c.Register(organization, waitEvent.WaitEventType, waitEvent.WaitEvent)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loaded[organization] = true
+
+	return nil
+}
+
+// Register adds a wait event of an organization missing from the catalogs with a generated color, the class is the wait_event_type
+// reported by PostgreSQL, unknown when it is empty or not a class. It returns whether the wait event is new,
+// the wait events above maxRegisteredWaitEvents for the organization are not registered.
+func (c *WaitEventCatalog) Register(organization, waitEventType, name string) (WaitEvent, bool) {
+	if _, ok := c.lookup(organization, name); ok || name == "" {
+		return WaitEvent{}, false
+	}
+
+	waitEvent := newUnknownWaitEvent(waitEventType, name)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	registered, ok := c.registered[organization]
+	if !ok {
+		registered = make(map[string]WaitEvent)
+		c.registered[organization] = registered
+	}
+	if _, ok := registered[name]; ok || len(registered) >= maxRegisteredWaitEvents {
+		return WaitEvent{}, false
+	}
+	registered[name] = waitEvent
+
+	return waitEvent, true
+}
+
+// newUnknownWaitEvent returns a wait event missing from the catalogs, the class is the wait_event_type
+// reported by PostgreSQL, unknown when it is empty or not a class
+func newUnknownWaitEvent(waitEventType, name string) WaitEvent {
+	class := waitEventType
+	if _, ok := waitEventClasses[class]; !ok {
+		class = unknownWaitEventClass
+	}
+
+	return WaitEvent{
+		Type:        name,
+		Class:       class,
+		Description: "The wait event is missing from the catalogs, it may be reported by an extension.",
+		Color:       generateColor(name),
+	}
+}
+
+// RegisterSamples registers the wait events of the samples of an organization missing from the catalogs and returns the new ones
+func (c *WaitEventCatalog) RegisterSamples(organization string, samples []*proto.ActivitySample) []string {
+	registered := make([]string, 0)
+	for _, sample := range samples {
+		if _, ok := c.Register(organization, sample.WaitEventType, sample.WaitEvent); ok {
+			registered = append(registered, sample.WaitEvent)
+		}
+	}
+
+	return registered
+}
+
+// addMissing adds to the wait events the names missing from them, registering the unknown ones for the organization,
+// and returns the added wait events.
+// The wait events of another version reported by a server, such as after an upgrade, are added as well.
+func (c *WaitEventCatalog) addMissing(organization string, waitEvents map[string]WaitEvent, names []string) map[string]WaitEvent {
+	added := make(map[string]WaitEvent)
+	for _, name := range names {
+		if _, ok := waitEvents[name]; ok || name == "" {
+			continue
+		}
+
+		waitEvent, ok := c.lookup(organization, name)
+		if !ok {
+			waitEvent, _ = c.Register(organization, "", name)
+			if waitEvent.Type == "" {
+				// the organization registered too many wait events, this one is shown without being remembered
+				waitEvent = newUnknownWaitEvent("", name)
+			}
+		}
+		waitEvents[name] = waitEvent
+		added[name] = waitEvent
+	}
+
+	return added
+}
+
+// waitEventNames returns the wait events keys of a load
+func waitEventNames[V any](load map[string]V) []string {
+	names := make([]string, 0, len(load))
+	for name := range load {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// lookup returns a wait event of the latest version reporting it or one registered for the organization
+func (c *WaitEventCatalog) lookup(organization, name string) (WaitEvent, bool) {
+	for version := uint32(maxCatalogVersion); version >= minCatalogVersion; version-- {
+		if waitEvent, ok := c.versions[version][name]; ok {
+			return waitEvent, true
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	waitEvent, ok := c.registered[organization][name]
+	return waitEvent, ok
+}
+
+// generateColor returns a stable color for a wait event, the hue is derived from the name
+func generateColor(name string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	hue := float64(hash.Sum32() % 360)
+
+	// HSL with a saturation of 60% and a lightness of 65% so that the colors stay readable on the profile
+	const saturation, lightness = 0.6, 0.65
+	chroma := (1 - math.Abs(2*lightness-1)) * saturation
+	x := chroma * (1 - math.Abs(math.Mod(hue/60, 2)-1))
+	m := lightness - chroma/2
+
+	var r, g, b float64
+	switch {
+	case hue < 60:
+		r, g, b = chroma, x, 0
+	case hue < 120:
+		r, g, b = x, chroma, 0
+	case hue < 180:
+		r, g, b = 0, chroma, x
+	case hue < 240:
+		r, g, b = 0, x, chroma
+	case hue < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", uint8(math.Round((r+m)*255)), uint8(math.Round((g+m)*255)), uint8(math.Round((b+m)*255)))
+}
@@ -0,0 +1,138 @@
+package activities
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"postgres-explain/proto"
+	"testing"
+)
+
+func loadCatalog(t *testing.T) *WaitEventCatalog {
+	catalog, err := LoadWaitEventCatalog("./")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return catalog
+}
+
+func TestMajorVersion(t *testing.T) {
+	tests := []struct {
+		name             string
+		serverVersionNum uint32
+		want             uint32
+	}{
+		{name: "unknown", serverVersionNum: 0, want: maxCatalogVersion},
+		{name: "pg12", serverVersionNum: 120017, want: 12},
+		{name: "pg16", serverVersionNum: 160002, want: 16},
+		{name: "older than the catalogs", serverVersionNum: 110022, want: minCatalogVersion},
+		{name: "newer than the catalogs", serverVersionNum: 180000, want: maxCatalogVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, majorVersion(tt.serverVersionNum))
+		})
+	}
+}
+
+func TestWaitEventCatalog_ForServerVersion(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	pg12 := catalog.ForServerVersion("org", 120017)
+	assert.Contains(t, pg12, "WALWriteLock")
+	assert.NotContains(t, pg12, "WALBufMapping")
+	assert.NotContains(t, pg12, "AppendReady")
+
+	pg16 := catalog.ForServerVersion("org", 160002)
+	assert.Contains(t, pg16, "WALBufMapping")
+	assert.Contains(t, pg16, "OldSnapshotTimeMap")
+	assert.NotContains(t, pg16, "WALWriteLock")
+
+	pg17 := catalog.ForServerVersion("org", 170000)
+	assert.NotContains(t, pg17, "OldSnapshotTimeMap")
+	assert.Contains(t, pg17, "WaitForStandbyConfirmation")
+	for name, waitEvent := range pg17 {
+		assert.NotEmpty(t, waitEvent.Color, "color of wait event %v is missing", name)
+	}
+}
+
+func TestWaitEventCatalog_Register(t *testing.T) {
+	catalog := NewWaitEventCatalog(
+		map[string]WaitEvent{"WALWrite": {Class: "LWLock", MinVersion: 13}},
+		map[string]WaitEvent{"PgVectorIndexBuild": {Description: "Waiting to build a vector index."}},
+	)
+	assert.Equal(t, customWaitEventClass, catalog.ForServerVersion("org", 0)["PgVectorIndexBuild"].Class)
+
+	registered := catalog.RegisterSamples("org", []*proto.ActivitySample{
+		{WaitEventType: "LWLock", WaitEvent: "WALWrite"},
+		{WaitEventType: "Extension", WaitEvent: "CitusDistributedDeadlock"},
+		{WaitEventType: "Extension", WaitEvent: "CitusDistributedDeadlock"},
+		{WaitEventType: "InjectionPoint", WaitEvent: "checkpoint-before-sync"},
+		{WaitEventType: "", WaitEvent: ""},
+	})
+	assert.Equal(t, []string{"CitusDistributedDeadlock", "checkpoint-before-sync"}, registered)
+
+	// the registered wait events are added to every version
+	pg12 := catalog.ForServerVersion("org", 120017)
+	assert.NotContains(t, pg12, "WALWrite")
+	assert.Equal(t, "Extension", pg12["CitusDistributedDeadlock"].Class)
+	assert.Equal(t, unknownWaitEventClass, pg12["checkpoint-before-sync"].Class)
+	assert.Equal(t, generateColor("CitusDistributedDeadlock"), pg12["CitusDistributedDeadlock"].Color)
+
+	// the wait events of another version are added from their catalog, the unknown ones are registered
+	added := catalog.addMissing("org", pg12, []string{"WALWrite", "CitusDistributedDeadlock", "MyExtensionWait"})
+	assert.Len(t, added, 2)
+	assert.Equal(t, "LWLock", pg12["WALWrite"].Class)
+	assert.Equal(t, unknownWaitEventClass, pg12["MyExtensionWait"].Class)
+	assert.Contains(t, catalog.ForServerVersion("org", 0), "MyExtensionWait")
+
+	// the wait events are registered for their organization only
+	other := catalog.ForServerVersion("other", 0)
+	assert.NotContains(t, other, "CitusDistributedDeadlock")
+	assert.NotContains(t, other, "MyExtensionWait")
+	assert.Contains(t, other, "PgVectorIndexBuild")
+}
+
+func TestWaitEventCatalog_RegisterLimit(t *testing.T) {
+	catalog := NewWaitEventCatalog(nil, nil)
+	for i := 0; i < maxRegisteredWaitEvents; i++ {
+		_, ok := catalog.Register("org", "Extension", fmt.Sprintf("Wait%v", i))
+		assert.True(t, ok)
+	}
+
+	_, ok := catalog.Register("org", "Extension", "OneTooMany")
+	assert.False(t, ok)
+	_, ok = catalog.Register("other", "Extension", "OneTooMany")
+	assert.True(t, ok)
+
+	// the wait events above the limit are still shown
+	waitEvents := catalog.ForServerVersion("org", 0)
+	added := catalog.addMissing("org", waitEvents, []string{"OneTooMany"})
+	assert.Equal(t, unknownWaitEventClass, added["OneTooMany"].Class)
+	assert.Len(t, catalog.ForServerVersion("org", 0), maxRegisteredWaitEvents)
+}
+
+func TestWaitEventCatalog_LoadOnce(t *testing.T) {
+	catalog := NewWaitEventCatalog(nil, nil)
+	calls := 0
+	load := func() ([]WaitEventNameDB, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("clickhouse is down")
+		}
+		return []WaitEventNameDB{{WaitEventType: "Extension", WaitEvent: "CitusDistributedDeadlock"}}, nil
+	}
+
+	assert.Error(t, catalog.LoadOnce("org", load))
+	assert.NoError(t, catalog.LoadOnce("org", load))
+	assert.NoError(t, catalog.LoadOnce("org", load))
+	assert.Equal(t, 2, calls)
+	assert.Contains(t, catalog.ForServerVersion("org", 0), "CitusDistributedDeadlock")
+}
+
+func TestGenerateColor(t *testing.T) {
+	color := generateColor("CitusDistributedDeadlock")
+	assert.Regexp(t, "^#[0-9a-f]{6}$", color)
+	assert.Equal(t, color, generateColor("CitusDistributedDeadlock"))
+	assert.NotEqual(t, color, generateColor("CitusDistributedDeadlock2"))
+}
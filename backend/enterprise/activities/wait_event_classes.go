@@ -0,0 +1,185 @@
+package activities
+
+import (
+	"fmt"
+	"postgres-explain/proto"
+)
+
+// unknownWaitEventClass is the class of the wait events missing from the catalogs whose wait_event_type is not known
+const unknownWaitEventClass = "Unknown"
+
+// waitEventClasses are the classes of the wait events, they are the traces of the class view of the profile
+var waitEventClasses = map[string]WaitEvent{
+	"CPU": {
+		Type:        "CPU",
+		Class:       "CPU",
+		Description: "The backend is running on CPU, it is not waiting.",
+		Color:       "#43a047",
+	},
+	"LWLock": {
+		Type:        "LWLock",
+		Class:       "LWLock",
+		Description: "The backend is waiting for a lightweight lock protecting a data structure in shared memory.",
+		Color:       "#e53935",
+	},
+	"Lock": {
+		Type:        "Lock",
+		Class:       "Lock",
+		Description: "The backend is waiting for a heavyweight lock, usually protecting a table or a row.",
+		Color:       "#8e0000",
+	},
+	"IO": {
+		Type:        "IO",
+		Class:       "IO",
+		Description: "The backend is waiting for an I/O operation to complete.",
+		Color:       "#1e88e5",
+	},
+	"IPC": {
+		Type:        "IPC",
+		Class:       "IPC",
+		Description: "The backend is waiting for some interaction with another process.",
+		Color:       "#fb8c00",
+	},
+	"Client": {
+		Type:        "Client",
+		Class:       "Client",
+		Description: "The backend is waiting on a socket for some activity from a user application.",
+		Color:       "#8e24aa",
+	},
+	"Activity": {
+		Type:        "Activity",
+		Class:       "Activity",
+		Description: "The backend is idle, waiting for activity in one of its main loops.",
+		Color:       "#90a4ae",
+	},
+	"Timeout": {
+		Type:        "Timeout",
+		Class:       "Timeout",
+		Description: "The backend is waiting for a timeout to expire.",
+		Color:       "#6d4c41",
+	},
+	"BufferPin": {
+		Type:        "BufferPin",
+		Class:       "BufferPin",
+		Description: "The backend is waiting for exclusive access to a data buffer.",
+		Color:       "#00897b",
+	},
+	"Extension": {
+		Type:        "Extension",
+		Class:       "Extension",
+		Description: "The backend is waiting for a condition defined by an extension.",
+		Color:       "#f06292",
+	},
+	unknownWaitEventClass: {
+		Type:        unknownWaitEventClass,
+		Class:       unknownWaitEventClass,
+		Description: "The backend is waiting for a wait event missing from the catalogs.",
+		Color:       "#9e9e9e",
+	},
+}
+
+// profileView is the level of detail of a profile: every wait event, their classes,
+// or only the wait events of a class when drilling down into it.
+type profileView struct {
+	byClass bool
+	class   string
+	// allWaitEvents are all the known wait events
+	allWaitEvents map[string]WaitEvent
+	// waitEvents are the keys of the traces of the view
+	waitEvents map[string]WaitEvent
+}
+
+func newProfileView(allWaitEvents map[string]WaitEvent, byClass bool, class string) (profileView, error) {
+	view := profileView{byClass: byClass, class: class, allWaitEvents: allWaitEvents, waitEvents: allWaitEvents}
+
+	switch {
+	case byClass && class != "":
+		return profileView{}, fmt.Errorf("by_class and wait_event_class cannot be used together")
+	case byClass:
+		view.waitEvents = waitEventClasses
+	case class != "":
+		if _, ok := waitEventClasses[class]; !ok {
+			return profileView{}, fmt.Errorf("wait event class %v is not valid", class)
+		}
+
+		view.waitEvents = make(map[string]WaitEvent)
+		for name, waitEvent := range allWaitEvents {
+			if waitEvent.Class == class {
+				view.waitEvents[name] = waitEvent
+			}
+		}
+	}
+
+	return view, nil
+}
+
+// add adds the wait events missing from the catalog of the view, the ones of the class when drilling down into it
+func (v profileView) add(waitEvents map[string]WaitEvent) {
+	for name, waitEvent := range waitEvents {
+		v.allWaitEvents[name] = waitEvent
+		if !v.byClass && (v.class == "" || waitEvent.Class == v.class) {
+			v.waitEvents[name] = waitEvent
+		}
+	}
+}
+
+// filter keeps only the results of the class when drilling down into it
+func (v profileView) filter(results []SlotDB) []SlotDB {
+	if v.class == "" {
+		return results
+	}
+
+	filtered := make([]SlotDB, 0, len(results))
+	for _, result := range results {
+		if _, ok := v.waitEvents[result.WaitEventName]; ok {
+			filtered = append(filtered, result)
+		}
+	}
+
+	return filtered
+}
+
+// aggregate sums the wait events of each slot into their classes in the class view
+func (v profileView) aggregate(slots Slots) Slots {
+	if !v.byClass {
+		return slots
+	}
+
+	classSlots := make(Slots, len(slots))
+	for timestamp, slot := range slots {
+		classSlot := make(Slot)
+		for waitEventName, value := range slot {
+			waitEvent, ok := v.allWaitEvents[waitEventName]
+			if !ok {
+				continue
+			}
+			classSlot[waitEvent.Class] += value
+		}
+		classSlots[timestamp] = classSlot
+	}
+
+	return classSlots
+}
+
+// toProtoWaitEvents returns the class and the description of the keys of the traces
+func toProtoWaitEvents(waitEvents map[string]WaitEvent, traces map[string]*proto.Trace) map[string]*proto.WaitEvent {
+	protoWaitEvents := make(map[string]*proto.WaitEvent, len(traces))
+	for name := range traces {
+		waitEvent, ok := waitEvents[name]
+		if !ok {
+			continue
+		}
+
+		protoWaitEvents[name] = waitEvent.toProto()
+	}
+
+	return protoWaitEvents
+}
+
+func (w WaitEvent) toProto() *proto.WaitEvent {
+	return &proto.WaitEvent{
+		Class:       w.Class,
+		Description: w.Description,
+		Color:       w.Color,
+	}
+}
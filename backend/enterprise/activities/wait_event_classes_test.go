@@ -0,0 +1,45 @@
+package activities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWaitEventClasses(t *testing.T) {
+	for name, waitEvent := range loadCatalog(t).ForServerVersion("org", 0) {
+		_, ok := waitEventClasses[waitEvent.Class]
+		assert.True(t, ok, "class %v of wait event %v is missing", waitEvent.Class, name)
+	}
+}
+
+func TestProfileView(t *testing.T) {
+	waitEvents := map[string]WaitEvent{
+		"WALWrite":     {Class: "LWLock"},
+		"LockManager":  {Class: "LWLock"},
+		"DataFileRead": {Class: "IO"},
+	}
+	timestamp := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	slots := Slots{timestamp: Slot{"WALWrite": 60, "LockManager": 30, "DataFileRead": 120}}
+	results := []SlotDB{{WaitEventName: "WALWrite"}, {WaitEventName: "DataFileRead"}}
+
+	_, err := newProfileView(waitEvents, true, "IO")
+	assert.Error(t, err)
+	_, err = newProfileView(waitEvents, false, "random")
+	assert.Error(t, err)
+
+	view, err := newProfileView(waitEvents, false, "")
+	assert.NoError(t, err)
+	assert.Equal(t, results, view.filter(results))
+	assert.Equal(t, slots, view.aggregate(slots))
+
+	view, err = newProfileView(waitEvents, true, "")
+	assert.NoError(t, err)
+	assert.Equal(t, waitEventClasses, view.waitEvents)
+	assert.Equal(t, Slots{timestamp: Slot{"LWLock": 90, "IO": 120}}, view.aggregate(slots))
+
+	view, err = newProfileView(waitEvents, false, "LWLock")
+	assert.NoError(t, err)
+	assert.Len(t, view.waitEvents, 2)
+	assert.Equal(t, []SlotDB{{WaitEventName: "WALWrite"}}, view.filter(results))
+}
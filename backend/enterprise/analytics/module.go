@@ -1,9 +1,11 @@
 package analytics
 
 import (
+	"fmt"
 	"github.com/borealisdb/commons/credentials"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/enterprise/shared"
 	"postgres-explain/backend/modules"
 	"postgres-explain/proto"
 )
@@ -15,6 +17,9 @@ type Module struct {
 	DB  *sqlx.DB
 
 	modules.Params
+
+	// stopped is closed once the last metrics buckets are stored
+	stopped chan struct{}
 }
 
 func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider credentials.Credentials, params modules.Params) {
@@ -25,14 +30,21 @@ func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider cr
 }
 
 func (m *Module) Init(initArgs modules.InitArgs) error {
+	if m.DB.DriverName() != modules.ClickHouseDriver {
+		return fmt.Errorf("module %v requires %v storage", ModuleName, modules.ClickHouseDriver)
+	}
+
 	metricsBucket := NewMetricsBucket(m.DB, m.Log)
 
 	receiver := &Receiver{
 		MetricsBucket: metricsBucket,
+		Deduplicator:  shared.NewBatchDeduplicator(shared.DeduplicationWindow, 0),
 		Log:           m.Log,
 	}
 
+	m.stopped = make(chan struct{})
 	go func() {
+		defer close(m.stopped)
 		metricsBucket.Run(initArgs.Ctx)
 	}()
 
@@ -40,3 +52,21 @@ func (m *Module) Init(initArgs modules.InitArgs) error {
 
 	return nil
 }
+
+// Wait waits for the metrics bucket to store the last buckets
+func (m *Module) Wait() {
+	if m.stopped != nil {
+		<-m.stopped
+	}
+}
+
+func (m *Module) Tables() []modules.Table {
+	return []modules.Table{{
+		Name:      "analytics",
+		SelectSQL: exportSQL,
+		InsertSQL: insertSQL,
+		NewRow: func() interface{} {
+			return &MetricsBucketExtended{}
+		},
+	}}
+}
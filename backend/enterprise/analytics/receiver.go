@@ -4,21 +4,39 @@ import (
 	"context"
 	"fmt"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"postgres-explain/backend/enterprise/shared"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
 )
 
 type Receiver struct {
 	proto.StatementsCollectorServer
 	MetricsBucket *MetricsBucket
-	Log           *logrus.Entry
+	// Deduplicator skips the requests retried by the collectors
+	Deduplicator *shared.BatchDeduplicator
+	Log          *logrus.Entry
 }
 
 func (s Receiver) Collect(ctx context.Context, request *proto.StatementsCollectRequest) (*proto.StatementsCollectResponse, error) {
 	s.Log.Infof("Received %+v statements samples", len(request.MetricsBucket))
 
-	if err := s.MetricsBucket.Save(request); err != nil {
+	org := organization.FromContext(ctx)
+	switch s.Deduplicator.Claim(org, request.BatchId) {
+	case shared.BatchStored:
+		s.Log.Infof("Skipped batch %v (sequence %v), it was already stored", request.BatchId, request.SequenceNumber)
+		return &proto.StatementsCollectResponse{}, nil
+	case shared.BatchPending:
+		// the first request of the batch may still fail, the collector retries until its result is known
+		return nil, status.Errorf(codes.Aborted, "batch %v is being stored, retry later", request.BatchId)
+	}
+
+	if err := s.MetricsBucket.Save(org, request); err != nil {
+		s.Deduplicator.Release(org, request.BatchId)
 		return nil, fmt.Errorf("could not Save: %v", err)
 	}
+	s.Deduplicator.Stored(org, request.BatchId)
 
 	return &proto.StatementsCollectResponse{}, nil
 }
@@ -7,19 +7,20 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/enterprise/shared"
 	"postgres-explain/proto"
 )
 
 const (
 	MetricsTableName = "metrics"
 	requestsCap      = 100
-	batchTimeout     = 500 * time.Millisecond
 	batchErrorDelay  = time.Second
 )
 
 const insertSQL = `
   INSERT INTO analytics
   (
+    organization,
     queryid,
     cluster_name,
     instance_name,
@@ -37,6 +38,8 @@ const insertSQL = `
     period_length,
     fingerprint,
     is_truncated,
+    cmd_type,
+    top_queryid,
     num_queries_with_warnings,
     warnings.code,
     warnings.count,
@@ -77,9 +80,35 @@ const insertSQL = `
     m_blk_read_time_cnt,
     m_blk_read_time_sum,
     m_blk_write_time_cnt,
-    m_blk_write_time_sum
+    m_blk_write_time_sum,
+    m_cpu_user_time_cnt,
+    m_cpu_user_time_sum,
+    m_cpu_sys_time_cnt,
+    m_cpu_sys_time_sum,
+    m_plans_calls_cnt,
+    m_plans_calls_sum,
+    m_plan_time_cnt,
+    m_plan_time_sum,
+    m_wal_records_cnt,
+    m_wal_records_sum,
+    m_wal_fpi_cnt,
+    m_wal_fpi_sum,
+    m_wal_bytes_cnt,
+    m_wal_bytes_sum,
+    m_jit_functions_cnt,
+    m_jit_functions_sum,
+    m_jit_generation_time_cnt,
+    m_jit_generation_time_sum,
+    m_jit_inlining_time_cnt,
+    m_jit_inlining_time_sum,
+    m_jit_optimization_time_cnt,
+    m_jit_optimization_time_sum,
+    m_jit_emission_time_cnt,
+    m_jit_emission_time_sum,
+    batch_id
    )
   VALUES (
+    :organization,
     :queryid,
     :cluster_name,
 	:instance_name,
@@ -97,6 +126,8 @@ const insertSQL = `
     :period_length_secs,
     :fingerprint,
     :is_truncated,
+    :cmd_type,
+    :top_queryid,
     :num_queries_with_warnings,
     :warnings_code,
     :warnings_count,
@@ -137,12 +168,132 @@ const insertSQL = `
     :m_blk_read_time_cnt,
     :m_blk_read_time_sum,
     :m_blk_write_time_cnt,
-    :m_blk_write_time_sum
+    :m_blk_write_time_sum,
+    :m_cpu_user_time_cnt,
+    :m_cpu_user_time_sum,
+    :m_cpu_sys_time_cnt,
+    :m_cpu_sys_time_sum,
+    :m_plans_calls_cnt,
+    :m_plans_calls_sum,
+    :m_plan_time_cnt,
+    :m_plan_time_sum,
+    :m_wal_records_cnt,
+    :m_wal_records_sum,
+    :m_wal_fpi_cnt,
+    :m_wal_fpi_sum,
+    :m_wal_bytes_cnt,
+    :m_wal_bytes_sum,
+    :m_jit_functions_cnt,
+    :m_jit_functions_sum,
+    :m_jit_generation_time_cnt,
+    :m_jit_generation_time_sum,
+    :m_jit_inlining_time_cnt,
+    :m_jit_inlining_time_sum,
+    :m_jit_optimization_time_cnt,
+    :m_jit_optimization_time_sum,
+    :m_jit_emission_time_cnt,
+    :m_jit_emission_time_sum,
+    :batch_id
   )
 `
 
+// exportSQL selects the rows of a cluster with the columns named after the parameters of insertSQL
+const exportSQL = `
+SELECT organization,
+       queryid,
+       cluster_name,
+       instance_name,
+       database,
+       schema,
+       tables,
+       username,
+       client_host,
+       replication_set,
+       environment,
+       labels.key AS labels_key,
+       labels.value AS labels_value,
+       agent_id,
+       period_start AS period_start_ts,
+       period_length AS period_length_secs,
+       fingerprint,
+       is_truncated,
+       cmd_type,
+       top_queryid,
+       num_queries_with_warnings,
+       arrayMap(x -> toUInt64(x), warnings.code) AS warnings_code,
+       arrayMap(x -> toUInt64(x), warnings.count) AS warnings_count,
+       num_queries_with_errors,
+       errors.code AS errors_code,
+       errors.count AS errors_count,
+       num_queries,
+       m_query_time_cnt,
+       m_query_time_sum,
+       m_query_time_min,
+       m_query_time_max,
+       m_query_time_p99,
+       m_rows_sent_cnt,
+       m_rows_sent_sum,
+       m_rows_sent_min,
+       m_rows_sent_max,
+       m_rows_sent_p99,
+       m_shared_blks_hit_cnt,
+       m_shared_blks_hit_sum,
+       m_shared_blks_read_cnt,
+       m_shared_blks_read_sum,
+       m_shared_blks_dirtied_cnt,
+       m_shared_blks_dirtied_sum,
+       m_shared_blks_written_cnt,
+       m_shared_blks_written_sum,
+       m_local_blks_hit_cnt,
+       m_local_blks_hit_sum,
+       m_local_blks_read_cnt,
+       m_local_blks_read_sum,
+       m_local_blks_dirtied_cnt,
+       m_local_blks_dirtied_sum,
+       m_local_blks_written_cnt,
+       m_local_blks_written_sum,
+       m_temp_blks_read_cnt,
+       m_temp_blks_read_sum,
+       m_temp_blks_written_cnt,
+       m_temp_blks_written_sum,
+       m_blk_read_time_cnt,
+       m_blk_read_time_sum,
+       m_blk_write_time_cnt,
+       m_blk_write_time_sum,
+       m_cpu_user_time_cnt,
+       m_cpu_user_time_sum,
+       m_cpu_sys_time_cnt,
+       m_cpu_sys_time_sum,
+       m_plans_calls_cnt,
+       m_plans_calls_sum,
+       m_plan_time_cnt,
+       m_plan_time_sum,
+       m_wal_records_cnt,
+       m_wal_records_sum,
+       m_wal_fpi_cnt,
+       m_wal_fpi_sum,
+       m_wal_bytes_cnt,
+       m_wal_bytes_sum,
+       m_jit_functions_cnt,
+       m_jit_functions_sum,
+       m_jit_generation_time_cnt,
+       m_jit_generation_time_sum,
+       m_jit_inlining_time_cnt,
+       m_jit_inlining_time_sum,
+       m_jit_optimization_time_cnt,
+       m_jit_optimization_time_sum,
+       m_jit_emission_time_cnt,
+       m_jit_emission_time_sum
+FROM analytics
+WHERE organization = :organization
+  AND cluster_name = :cluster_name
+  AND period_start >= :period_start_from
+  AND period_start <= :period_start_to
+ORDER BY period_start`
+
 // MetricsBucketExtended extends proto MetricsBucket to store converted data into db.
 type MetricsBucketExtended struct {
+	Organization     string    `json:"organization"`
 	PeriodStart      time.Time `json:"period_start_ts"`
 	LabelsKey        []string  `json:"labels_key"`
 	LabelsValues     []string  `json:"labels_value"`
@@ -151,18 +302,33 @@ type MetricsBucketExtended struct {
 	ErrorsCode       []uint64  `json:"errors_code"`
 	ErrorsCount      []uint64  `json:"errors_count"`
 	IsQueryTruncated uint8     `json:"is_query_truncated"` // uint32 -> uint8
+	BatchID          string    `json:"batch_id"`
 	*proto.MetricsBucket
 }
 
+func (m *MetricsBucketExtended) GetOrganization() string {
+	return m.Organization
+}
+
+func (m *MetricsBucketExtended) SetOrganization(organization string) {
+	m.Organization = organization
+}
+
+// collectRequest is a request of a collector together with the organization it belongs to
+type collectRequest struct {
+	organization string
+	*proto.StatementsCollectRequest
+}
+
 // MetricsBucket implements models to store metrics bucket
 type MetricsBucket struct {
 	db         *sqlx.DB
 	l          *logrus.Entry
-	requestsCh chan *proto.StatementsCollectRequest
+	requestsCh chan collectRequest
 }
 
 func NewMetricsBucket(db *sqlx.DB, log *logrus.Entry) *MetricsBucket {
-	requestsCh := make(chan *proto.StatementsCollectRequest, requestsCap)
+	requestsCh := make(chan collectRequest, requestsCap)
 
 	mb := &MetricsBucket{
 		db:         db,
@@ -182,26 +348,19 @@ func (mb *MetricsBucket) Run(ctx context.Context) {
 		close(mb.requestsCh)
 	}()
 
-	for ctx.Err() == nil {
-		if err := mb.insertBatch(batchTimeout); err != nil {
-			mb.l.Errorf("could not insert batch: %v", err)
+	for req := range mb.requestsCh {
+		if err := mb.insertRequest(req); err != nil {
+			mb.l.Errorf("could not insert request: %v", err)
 			time.Sleep(batchErrorDelay)
 		}
 	}
 
-	// insert one last final batch
-	_ = mb.insertBatch(0)
+	mb.l.Warn("Requests channel closed, nothing left to store.")
 }
 
-func (mb *MetricsBucket) insertBatch(timeout time.Duration) (err error) {
-	// wait for first request before doing anything, ignore timeout
-	req, ok := <-mb.requestsCh
-	if !ok {
-		mb.l.Warn("Requests channel closed, nothing to store.")
-		return
-	}
-
-	var buckets int
+// insertRequest inserts the buckets of a request with its own INSERT, so that ClickHouse deduplicates the retried requests
+func (mb *MetricsBucket) insertRequest(req collectRequest) (err error) {
+	buckets := len(req.MetricsBucket)
 	start := time.Now()
 	defer func() {
 		d := time.Since(start)
@@ -213,9 +372,11 @@ func (mb *MetricsBucket) insertBatch(timeout time.Duration) (err error) {
 		}
 	}()
 
+	ctx := shared.DeduplicationContext(context.Background(), req.organization, req.BatchId)
+
 	// begin "transaction" and commit or rollback it on exit
 	var tx *sqlx.Tx
-	if tx, err = mb.db.Beginx(); err != nil {
+	if tx, err = mb.db.BeginTxx(ctx, nil); err != nil {
 		err = errors.Wrap(err, "failed to begin transaction")
 		return
 	}
@@ -231,7 +392,7 @@ func (mb *MetricsBucket) insertBatch(timeout time.Duration) (err error) {
 
 	// prepare INSERT statement and close it on exit
 	var stmt *sqlx.NamedStmt
-	if stmt, err = tx.PrepareNamed(insertSQL); err != nil {
+	if stmt, err = tx.PrepareNamedContext(ctx, insertSQL); err != nil {
 		err = errors.Wrap(err, "failed to prepare statement")
 		return
 	}
@@ -241,68 +402,47 @@ func (mb *MetricsBucket) insertBatch(timeout time.Duration) (err error) {
 		}
 	}()
 
-	// limit only by time, not by batch size, because large batches already handled by the driver
-	// ("block_size" query parameter)
-	var timeoutCh <-chan time.Time
-	if timeout > 0 {
-		t := time.NewTimer(timeout)
-		defer t.Stop()
-		timeoutCh = t.C
-	}
+	for _, metricsBucket := range req.MetricsBucket {
+		lk, lv := mapToArrsStrStr(metricsBucket.Labels)
+		wk, wv := mapToArrsIntInt(metricsBucket.Warnings)
+		ek, ev := mapToArrsIntInt(metricsBucket.Errors)
 
-	for {
-		// INSERT buckets from current request
-		for _, metricsBucket := range req.MetricsBucket {
-			buckets++
-
-			lk, lv := mapToArrsStrStr(metricsBucket.Labels)
-			wk, wv := mapToArrsIntInt(metricsBucket.Warnings)
-			ek, ev := mapToArrsIntInt(metricsBucket.Errors)
-
-			var truncated uint8
-			if metricsBucket.IsTruncated {
-				truncated = 1
-			}
-
-			q := MetricsBucketExtended{
-				time.Unix(int64(metricsBucket.GetPeriodStartUnixSecs()), 0).UTC(),
-				lk,
-				lv,
-				wk,
-				wv,
-				ek,
-				ev,
-				truncated,
-				metricsBucket,
-			}
-
-			if _, err = stmt.Exec(q); err != nil {
-				err = errors.Wrap(err, "failed to exec")
-				return
-			}
+		var truncated uint8
+		if metricsBucket.IsTruncated {
+			truncated = 1
 		}
 
-		// wait for the next request or exit on timer
-		select {
-		case req, ok = <-mb.requestsCh:
-			if !ok {
-				mb.l.Warn("Requests channel closed, exiting.")
-				return
-			}
-		case <-timeoutCh:
+		q := MetricsBucketExtended{
+			req.organization,
+			time.Unix(int64(metricsBucket.GetPeriodStartUnixSecs()), 0).UTC(),
+			lk,
+			lv,
+			wk,
+			wv,
+			ek,
+			ev,
+			truncated,
+			req.BatchId,
+			metricsBucket,
+		}
+
+		if _, err = stmt.Exec(q); err != nil {
+			err = errors.Wrap(err, "failed to exec")
 			return
 		}
 	}
+
+	return nil
 }
 
 // Save store metrics bucket received from agent into db.
-func (mb *MetricsBucket) Save(agentMsg *proto.StatementsCollectRequest) error {
+func (mb *MetricsBucket) Save(organization string, agentMsg *proto.StatementsCollectRequest) error {
 	if len(agentMsg.MetricsBucket) == 0 {
 		mb.l.Warnf("Nothing to save - no metrics buckets.")
 		return nil
 	}
 
-	mb.requestsCh <- agentMsg
+	mb.requestsCh <- collectRequest{organization: organization, StatementsCollectRequest: agentMsg}
 	return nil
 }
 
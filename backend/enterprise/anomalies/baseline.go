@@ -0,0 +1,130 @@
+package anomalies
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// bucketWidth is the width of the buckets of the series, the buckets of the hourly rollups
+	bucketWidth = time.Hour
+	// baselineWeeks are the weeks before a bucket forming its baseline
+	baselineWeeks = 4
+	// minBaselinePoints is the minimum number of buckets of a baseline to evaluate a bucket, one per week with load
+	minBaselinePoints = 3
+	// scoreThreshold is the robust z-score above which a bucket is anomalous
+	scoreThreshold = 3.5
+	// minDeviation is the minimum rise of the average active sessions above the median to be anomalous,
+	// so that the flat baselines of idle clusters do not flag every small rise
+	minDeviation = 0.5
+	// minMAD bounds the median absolute deviation of flat baselines
+	minMAD = 0.01
+	// madScale makes the median absolute deviation consistent with the standard deviation of a normal distribution
+	madScale = 0.6745
+)
+
+// Point is the average active sessions of a bucket
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series are the points of a cluster or of a fingerprint ordered by time
+type Series []Point
+
+// Window is a run of consecutive anomalous buckets
+type Window struct {
+	Start          time.Time
+	End            time.Time
+	Value          float64
+	BaselineMedian float64
+	BaselineMAD    float64
+	Score          float64
+}
+
+// hourOfWeek returns the key of the seasonal baseline of a time
+func hourOfWeek(t time.Time) int {
+	t = t.UTC()
+	return int(t.Weekday())*24 + t.Hour()
+}
+
+// median returns the median of the values, the values are sorted
+func median(values []float64) float64 {
+	sort.Float64s(values)
+
+	middle := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[middle-1] + values[middle]) / 2
+	}
+
+	return values[middle]
+}
+
+// medianAbsoluteDeviation returns the median of the values and their median absolute deviation
+func medianAbsoluteDeviation(values []float64) (float64, float64) {
+	m := median(values)
+
+	deviations := make([]float64, 0, len(values))
+	for _, value := range values {
+		deviations = append(deviations, math.Abs(value-m))
+	}
+
+	return m, median(deviations)
+}
+
+// detect evaluates the buckets of the series from the given time against the hour of week baseline
+// of the buckets before it and returns the windows of anomalous buckets. Only rises of the load are anomalies.
+// The missing buckets are skipped: nothing is known about them, the cluster may not have been monitored.
+func (s Series) detect(from time.Time) []Window {
+	baselines := make(map[int][]float64)
+	for _, point := range s {
+		if point.Time.Before(from) {
+			key := hourOfWeek(point.Time)
+			baselines[key] = append(baselines[key], point.Value)
+		}
+	}
+
+	windows := make([]Window, 0)
+	// anomalous is set while the previous bucket is anomalous, the last window is extended
+	anomalous := false
+	for _, point := range s {
+		if point.Time.Before(from) {
+			continue
+		}
+		if anomalous && !point.Time.Equal(windows[len(windows)-1].End) {
+			anomalous = false
+		}
+
+		baseline := baselines[hourOfWeek(point.Time)]
+		if len(baseline) < minBaselinePoints {
+			anomalous = false
+			continue
+		}
+
+		m, mad := medianAbsoluteDeviation(append([]float64(nil), baseline...))
+		deviation := point.Value - m
+		score := madScale * deviation / math.Max(mad, minMAD)
+		if deviation < minDeviation || score < scoreThreshold {
+			anomalous = false
+			continue
+		}
+
+		if !anomalous {
+			windows = append(windows, Window{Start: point.Time})
+			anomalous = true
+		}
+		current := &windows[len(windows)-1]
+		current.End = point.Time.Add(bucketWidth)
+		if point.Value > current.Value {
+			current.Value = point.Value
+			current.BaselineMedian = m
+			current.BaselineMAD = mad
+		}
+		if score > current.Score {
+			current.Score = score
+		}
+	}
+
+	return windows
+}
@@ -0,0 +1,73 @@
+package anomalies
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	m, mad := medianAbsoluteDeviation([]float64{1, 1, 2, 2, 4, 6, 9})
+	assert.Equal(t, float64(2), m)
+	assert.Equal(t, float64(1), mad)
+
+	m, mad = medianAbsoluteDeviation([]float64{4, 1, 3, 2})
+	assert.Equal(t, 2.5, m)
+	assert.Equal(t, float64(1), mad)
+}
+
+// baselineSeries returns the buckets of the baseline weeks before evaluateFrom, their load alternates between 1 and 1.5 every week
+func baselineSeries(evaluateFrom time.Time) Series {
+	baselineStart := evaluateFrom.AddDate(0, 0, -7*baselineWeeks)
+	series := make(Series, 0)
+	for t := baselineStart; t.Before(evaluateFrom); t = t.Add(bucketWidth) {
+		value := 1.0
+		if int(t.Sub(baselineStart)/(7*24*time.Hour))%2 == 1 {
+			value = 1.5
+		}
+		series = append(series, Point{Time: t, Value: value})
+	}
+
+	return series
+}
+
+func TestSeries_detect(t *testing.T) {
+	evaluateFrom := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+	series := baselineSeries(evaluateFrom)
+	// the first two buckets are spikes, then a small rise, then a spike again
+	for i, value := range []float64{8, 6, 1.6, 1, 7, 1} {
+		series = append(series, Point{Time: evaluateFrom.Add(time.Duration(i) * bucketWidth), Value: value})
+	}
+
+	windows := series.detect(evaluateFrom)
+	assert.Len(t, windows, 2)
+	assert.Equal(t, evaluateFrom, windows[0].Start)
+	assert.Equal(t, evaluateFrom.Add(2*bucketWidth), windows[0].End)
+	assert.Equal(t, float64(8), windows[0].Value)
+	assert.Equal(t, 1.25, windows[0].BaselineMedian)
+	assert.Equal(t, 0.25, windows[0].BaselineMAD)
+	assert.Greater(t, windows[0].Score, scoreThreshold)
+	assert.Equal(t, evaluateFrom.Add(4*bucketWidth), windows[1].Start)
+}
+
+func TestSeries_detectMissingBuckets(t *testing.T) {
+	evaluateFrom := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+	series := baselineSeries(evaluateFrom)
+	// the second bucket is missing, it ends the window instead of counting as no load
+	series = append(series,
+		Point{Time: evaluateFrom, Value: 8},
+		Point{Time: evaluateFrom.Add(2 * bucketWidth), Value: 8},
+	)
+
+	windows := series.detect(evaluateFrom)
+	assert.Len(t, windows, 2)
+	assert.Equal(t, evaluateFrom.Add(bucketWidth), windows[0].End)
+	assert.Equal(t, evaluateFrom.Add(2*bucketWidth), windows[1].Start)
+}
+
+func TestSeries_detectWithoutBaseline(t *testing.T) {
+	evaluateFrom := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+	series := Series{{Time: evaluateFrom.Add(-time.Hour), Value: 1}, {Time: evaluateFrom, Value: 10}}
+
+	assert.Empty(t, series.detect(evaluateFrom))
+}
@@ -0,0 +1,95 @@
+package anomalies
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	// detectionInterval is the time between two detections
+	detectionInterval = 10 * time.Minute
+	// evaluatedWindow are the last buckets evaluated by a detection, the windows of the previous detection are updated
+	evaluatedWindow = time.Hour
+	// topFingerprints is the number of fingerprints of each cluster evaluated by a detection
+	topFingerprints = 10
+)
+
+// Detector compares the load of the last buckets of the clusters and of their top fingerprints
+// with their hour of week baselines and stores the anomalies.
+type Detector struct {
+	repo Repository
+	log  *logrus.Entry
+}
+
+func NewDetector(repo Repository, log *logrus.Entry) *Detector {
+	return &Detector{repo: repo, log: log.WithField("subcomponent", "anomalies-detector")}
+}
+
+// Run detects anomalies every detectionInterval until the context is canceled
+func (d *Detector) Run(ctx context.Context) {
+	ticker := time.NewTicker(detectionInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.detect(ctx, time.Now()); err != nil {
+			d.log.Errorf("could not detect anomalies: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// nothing
+		}
+	}
+}
+
+func (d *Detector) detect(ctx context.Context, now time.Time) error {
+	// only the complete buckets are evaluated
+	to := now.UTC().Truncate(bucketWidth)
+	evaluateFrom := to.Add(-evaluatedWindow)
+	from := evaluateFrom.AddDate(0, 0, -7*baselineWeeks)
+
+	aasSeries, err := d.repo.GetAASSeries(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	queryLoadSeries, err := d.repo.GetQueryLoadSeries(ctx, from, evaluateFrom, to, topFingerprints)
+	if err != nil {
+		return err
+	}
+
+	anomalies := detectAnomalies(KindAAS, aasSeries, evaluateFrom, now)
+	anomalies = append(anomalies, detectAnomalies(KindQueryLoad, queryLoadSeries, evaluateFrom, now)...)
+	if err := d.repo.Save(anomalies); err != nil {
+		return err
+	}
+
+	d.log.Debugf("Detected %d anomalies", len(anomalies))
+	return nil
+}
+
+// detectAnomalies returns the anomalies of the series from evaluateFrom
+func detectAnomalies(kind string, series map[SeriesKey]Series, evaluateFrom, detectedAt time.Time) []AnomalyDB {
+	anomalies := make([]AnomalyDB, 0)
+	for key, s := range series {
+		for _, window := range s.detect(evaluateFrom) {
+			anomalies = append(anomalies, AnomalyDB{
+				Organization:   key.Organization,
+				ClusterName:    key.ClusterName,
+				Kind:           kind,
+				Fingerprint:    key.Fingerprint,
+				WindowStart:    window.Start,
+				WindowEnd:      window.End,
+				Value:          window.Value,
+				BaselineMedian: window.BaselineMedian,
+				BaselineMAD:    window.BaselineMAD,
+				Score:          window.Score,
+				DetectedAt:     detectedAt.UTC(),
+			})
+		}
+	}
+
+	return anomalies
+}
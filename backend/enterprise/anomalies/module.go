@@ -0,0 +1,49 @@
+package anomalies
+
+import (
+	"fmt"
+	"github.com/borealisdb/commons/credentials"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"postgres-explain/backend/modules"
+	"postgres-explain/proto"
+)
+
+const ModuleName = "anomalies"
+
+type Module struct {
+	Log *logrus.Entry
+	DB  *sqlx.DB
+
+	modules.Params
+}
+
+func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider credentials.Credentials, params modules.Params) {
+	m.Log = log.WithField("module", ModuleName)
+	m.DB = db
+	m.Log.Infof("registered")
+	m.Params = params
+}
+
+func (m *Module) Init(initArgs modules.InitArgs) error {
+	if m.DB.DriverName() != modules.ClickHouseDriver {
+		return fmt.Errorf("module %v requires %v storage", ModuleName, modules.ClickHouseDriver)
+	}
+
+	repo := NewRepository(m.DB)
+	if m.Params.AnomaliesDetector {
+		detector := NewDetector(repo, m.Log)
+		go func() {
+			detector.Run(initArgs.Ctx)
+		}()
+	} else {
+		m.Log.Infof("detector disabled, the anomalies are detected by another replica")
+	}
+
+	proto.RegisterAnomaliesServer(initArgs.GrpcServer, NewService(repo, m.Log))
+	if err := proto.RegisterAnomaliesHandlerFromEndpoint(initArgs.Ctx, initArgs.Mux, initArgs.GrpcAddress, initArgs.Opts); err != nil {
+		return fmt.Errorf("could not RegisterAnomaliesHandlerFromEndpoint: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package anomalies
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"postgres-explain/backend/enterprise/shared"
+	"time"
+)
+
+const (
+	AnomaliesTableName = "anomalies"
+
+	// KindAAS is the kind of the anomalies of the load of a cluster
+	KindAAS = "aas"
+	// KindQueryLoad is the kind of the anomalies of the load of a fingerprint
+	KindQueryLoad = "query_load"
+)
+
+// The load of the clusters is read from the rollup of the buckets width
+const aasSeriesSQL = `
+SELECT organization,
+       cluster_name,
+       bucket_start,
+       sum(db_time_secs) / :bucket_secs AS aas
+FROM activities_1h
+WHERE bucket_start >= :period_start_from
+  AND bucket_start < :period_start_to
+GROUP BY organization, cluster_name, bucket_start
+ORDER BY organization, cluster_name, bucket_start`
+
+// The load of the top fingerprints of the evaluated buckets is read from the fingerprints rollup of the buckets width
+const queryLoadSeriesSQL = `
+SELECT organization,
+       cluster_name,
+       fingerprint,
+       bucket_start,
+       sum(db_time_secs) / :bucket_secs AS aas
+FROM activities_fingerprints_1h
+WHERE bucket_start >= :period_start_from
+  AND bucket_start < :period_start_to
+  AND (organization, cluster_name, fingerprint) IN (SELECT organization, cluster_name, fingerprint
+                                                    FROM activities_fingerprints_1h
+                                                    WHERE bucket_start >= :evaluate_from
+                                                      AND bucket_start < :period_start_to
+                                                    GROUP BY organization, cluster_name, fingerprint
+                                                    ORDER BY sum(db_time_secs) DESC
+                                                    LIMIT :top_fingerprints BY organization, cluster_name)
+GROUP BY organization, cluster_name, fingerprint, bucket_start
+ORDER BY organization, cluster_name, fingerprint, bucket_start`
+
+const insertAnomalySQL = `
+  INSERT INTO anomalies
+  (
+    organization,
+    cluster_name,
+    kind,
+    fingerprint,
+    window_start,
+    window_end,
+    value,
+    baseline_median,
+    baseline_mad,
+    score,
+    detected_at
+  )
+  VALUES (
+    :organization,
+    :cluster_name,
+    :kind,
+    :fingerprint,
+    :window_start,
+    :window_end,
+    :value,
+    :baseline_median,
+    :baseline_mad,
+    :score,
+    :detected_at
+  )
+`
+
+// The windows of an anomaly detected again are replaced, the windows overlapping the range are returned
+const selectAnomaliesSQLTemplate = `
+SELECT organization,
+       cluster_name,
+       kind,
+       fingerprint,
+       window_start,
+       window_end,
+       value,
+       baseline_median,
+       baseline_mad,
+       score,
+       detected_at
+FROM anomalies FINAL
+WHERE window_end > :period_start_from
+  AND window_start < :period_start_to
+  AND organization = :organization
+  AND cluster_name = :cluster_name{{ if .Kind }}
+  AND kind = :kind{{ end }}{{ if .Fingerprint }}
+  AND fingerprint = :fingerprint{{ end }}
+ORDER BY window_start`
+
+// SeriesKey identifies the series of a cluster, or of a fingerprint of a cluster
+type SeriesKey struct {
+	Organization string `json:"organization"`
+	ClusterName  string `json:"cluster_name"`
+	Fingerprint  string `json:"fingerprint"`
+}
+
+type seriesPointDB struct {
+	SeriesKey
+	BucketStart time.Time `json:"bucket_start"`
+	AAS         float64   `json:"aas"`
+}
+
+type AnomalyDB struct {
+	Organization   string    `json:"organization"`
+	ClusterName    string    `json:"cluster_name"`
+	Kind           string    `json:"kind"`
+	Fingerprint    string    `json:"fingerprint"`
+	WindowStart    time.Time `json:"window_start"`
+	WindowEnd      time.Time `json:"window_end"`
+	Value          float64   `json:"value"`
+	BaselineMedian float64   `json:"baseline_median"`
+	BaselineMAD    float64   `json:"baseline_mad"`
+	Score          float64   `json:"score"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
+type AnomaliesArgs struct {
+	Organization       string
+	ClusterName        string
+	PeriodStartFromSec int64
+	PeriodStartToSec   int64
+	Kind               string
+	Fingerprint        string
+}
+
+type Repository struct {
+	DB *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) Repository {
+	return Repository{DB: db}
+}
+
+// GetAASSeries returns the load of every cluster in the range
+func (r Repository) GetAASSeries(ctx context.Context, from, to time.Time) (map[SeriesKey]Series, error) {
+	return r.getSeries(ctx, aasSeriesSQL, map[string]interface{}{
+		"period_start_from": from,
+		"period_start_to":   to,
+		"bucket_secs":       int64(bucketWidth / time.Second),
+	})
+}
+
+// GetQueryLoadSeries returns the load in the range of the top fingerprints of every cluster since evaluateFrom
+func (r Repository) GetQueryLoadSeries(ctx context.Context, from, evaluateFrom, to time.Time, topFingerprints int) (map[SeriesKey]Series, error) {
+	return r.getSeries(ctx, queryLoadSeriesSQL, map[string]interface{}{
+		"period_start_from": from,
+		"period_start_to":   to,
+		"evaluate_from":     evaluateFrom,
+		"top_fingerprints":  topFingerprints,
+		"bucket_secs":       int64(bucketWidth / time.Second),
+	})
+}
+
+func (r Repository) getSeries(ctx context.Context, query string, args map[string]interface{}) (map[SeriesKey]Series, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := r.DB.NamedQueryContext(queryCtx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("could not NamedQueryContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	series := make(map[SeriesKey]Series)
+	for rows.Next() {
+		point := seriesPointDB{}
+		if err := rows.StructScan(&point); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		series[point.SeriesKey] = append(series[point.SeriesKey], Point{Time: point.BucketStart.UTC(), Value: point.AAS})
+	}
+
+	return series, nil
+}
+
+// Save stores the detected anomalies
+func (r Repository) Save(anomalies []AnomalyDB) (err error) {
+	if len(anomalies) == 0 {
+		return nil
+	}
+
+	// begin "transaction" and commit or rollback it on exit
+	var tx *sqlx.Tx
+	if tx, err = r.DB.Beginx(); err != nil {
+		err = errors.Wrap(err, "failed to begin transaction")
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(err, "failed to commit transaction")
+		}
+	}()
+
+	// prepare INSERT statement and close it on exit
+	var stmt *sqlx.NamedStmt
+	if stmt, err = tx.PrepareNamed(insertAnomalySQL); err != nil {
+		err = errors.Wrap(err, "failed to prepare statement")
+		return
+	}
+	defer func() {
+		if e := stmt.Close(); e != nil && err == nil {
+			err = errors.Wrap(e, "failed to close statement")
+		}
+	}()
+
+	for _, anomaly := range anomalies {
+		if _, err = stmt.Exec(anomaly); err != nil {
+			err = errors.Wrap(err, "failed to exec")
+			return
+		}
+	}
+
+	return nil
+}
+
+// Select returns the anomalies overlapping the range ordered by start
+func (r Repository) Select(ctx context.Context, args AnomaliesArgs) ([]AnomalyDB, error) {
+	queryArgs := map[string]interface{}{
+		"period_start_from": args.PeriodStartFromSec,
+		"period_start_to":   args.PeriodStartToSec,
+		"organization":      args.Organization,
+		"cluster_name":      args.ClusterName,
+		"kind":              args.Kind,
+		"fingerprint":       args.Fingerprint,
+	}
+	query, queryArgsList, err := shared.ProcessQueryWithTemplate(args, queryArgs, selectAnomaliesSQLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not ProcessQueryWithTemplate: %v", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, shared.QueryTimeout)
+	defer cancel()
+
+	rows, err := r.DB.QueryxContext(queryCtx, r.DB.Rebind(query), queryArgsList...)
+	if err != nil {
+		return nil, fmt.Errorf("could not QueryxContext: %v", err)
+	}
+
+	defer rows.Close()
+
+	anomalies := make([]AnomalyDB, 0)
+	for rows.Next() {
+		anomaly := AnomalyDB{}
+		if err := rows.StructScan(&anomaly); err != nil {
+			return nil, fmt.Errorf("could not StructScan: %v", err)
+		}
+
+		anomalies = append(anomalies, anomaly)
+	}
+
+	return anomalies, nil
+}
@@ -0,0 +1,100 @@
+package anomalies
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"postgres-explain/backend/enterprise/shared"
+	"postgres-explain/backend/organization"
+	"postgres-explain/proto"
+	"sort"
+)
+
+type Service struct {
+	Repo Repository
+	log  *logrus.Entry
+
+	proto.AnomaliesServer
+}
+
+func NewService(repo Repository, log *logrus.Entry) *Service {
+	return &Service{Repo: repo, log: log.WithField("subcomponent", "anomalies")}
+}
+
+func (s *Service) GetAnomalies(ctx context.Context, in *proto.GetAnomaliesRequest) (*proto.GetAnomaliesResponse, error) {
+	if err := shared.ValidateCommonRequestProps(shared.Validate{
+		PeriodStartFrom: in.PeriodStartFrom,
+		PeriodStartTo:   in.PeriodStartTo,
+		ClusterName:     in.ClusterName,
+	}); err != nil {
+		return nil, err
+	}
+	if in.Kind != "" && in.Kind != KindAAS && in.Kind != KindQueryLoad {
+		return nil, fmt.Errorf("kind %v is not valid, must be one of: %v, %v", in.Kind, KindAAS, KindQueryLoad)
+	}
+
+	anomalies, err := s.Repo.Select(ctx, AnomaliesArgs{
+		Organization:       organization.FromContext(ctx),
+		ClusterName:        in.ClusterName,
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		Kind:               in.Kind,
+		Fingerprint:        in.Fingerprint,
+	})
+	if err != nil {
+		s.log.Errorf("error querying clickhouse: %v", err)
+		return &proto.GetAnomaliesResponse{}, fmt.Errorf("something went wrong")
+	}
+
+	return &proto.GetAnomaliesResponse{Anomalies: ToProto(anomalies)}, nil
+}
+
+// ToProto merges the overlapping windows of the same series, each detection updates the windows of its last buckets,
+// thus a long anomaly is stored as several overlapping windows.
+func ToProto(anomalies []AnomalyDB) []*proto.Anomaly {
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		return anomalies[i].WindowStart.Before(anomalies[j].WindowStart)
+	})
+
+	type seriesKey struct {
+		kind        string
+		fingerprint string
+	}
+
+	protoAnomalies := make([]*proto.Anomaly, 0, len(anomalies))
+	// last are the last windows of each series
+	last := make(map[seriesKey]*proto.Anomaly)
+	for _, anomaly := range anomalies {
+		key := seriesKey{kind: anomaly.Kind, fingerprint: anomaly.Fingerprint}
+		if previous, ok := last[key]; ok && !anomaly.WindowStart.After(previous.End.AsTime()) {
+			if anomaly.WindowEnd.After(previous.End.AsTime()) {
+				previous.End = timestamppb.New(anomaly.WindowEnd)
+			}
+			if float32(anomaly.Value) > previous.Value {
+				previous.Value = float32(anomaly.Value)
+				previous.BaselineMedian = float32(anomaly.BaselineMedian)
+				previous.BaselineMad = float32(anomaly.BaselineMAD)
+			}
+			if float32(anomaly.Score) > previous.Score {
+				previous.Score = float32(anomaly.Score)
+			}
+			continue
+		}
+
+		protoAnomaly := &proto.Anomaly{
+			Kind:           anomaly.Kind,
+			Fingerprint:    anomaly.Fingerprint,
+			Start:          timestamppb.New(anomaly.WindowStart),
+			End:            timestamppb.New(anomaly.WindowEnd),
+			Value:          float32(anomaly.Value),
+			BaselineMedian: float32(anomaly.BaselineMedian),
+			BaselineMad:    float32(anomaly.BaselineMAD),
+			Score:          float32(anomaly.Score),
+		}
+		last[key] = protoAnomaly
+		protoAnomalies = append(protoAnomalies, protoAnomaly)
+	}
+
+	return protoAnomalies
+}
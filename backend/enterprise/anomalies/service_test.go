@@ -0,0 +1,28 @@
+package anomalies
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestToProto(t *testing.T) {
+	start := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+	anomalies := []AnomalyDB{
+		// a long anomaly detected by two detections
+		{Kind: KindAAS, WindowStart: start.Add(time.Hour), WindowEnd: start.Add(90 * time.Minute), Value: 6, Score: 10},
+		{Kind: KindAAS, WindowStart: start, WindowEnd: start.Add(time.Hour), Value: 8, BaselineMedian: 1, Score: 8},
+		{Kind: KindQueryLoad, Fingerprint: "a", WindowStart: start, WindowEnd: start.Add(10 * time.Minute), Value: 2},
+		{Kind: KindAAS, WindowStart: start.Add(3 * time.Hour), WindowEnd: start.Add(4 * time.Hour), Value: 4},
+	}
+
+	protoAnomalies := ToProto(anomalies)
+	assert.Len(t, protoAnomalies, 3)
+	assert.Equal(t, start, protoAnomalies[0].Start.AsTime())
+	assert.Equal(t, start.Add(90*time.Minute), protoAnomalies[0].End.AsTime())
+	assert.Equal(t, float32(8), protoAnomalies[0].Value)
+	assert.Equal(t, float32(1), protoAnomalies[0].BaselineMedian)
+	assert.Equal(t, float32(10), protoAnomalies[0].Score)
+	assert.Equal(t, "a", protoAnomalies[1].Fingerprint)
+	assert.Equal(t, start.Add(3*time.Hour), protoAnomalies[2].Start.AsTime())
+}
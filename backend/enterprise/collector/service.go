@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"postgres-explain/backend/cache"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
 )
 
@@ -16,13 +17,30 @@ type Service struct {
 }
 
 func (s Service) Register(ctx context.Context, request *proto.RegisterRequest) (*proto.RegisterResponse, error) {
-	s.log.Infof("received registration from cluster %v and instance %v", request.ClusterName, request.InstanceName)
+	org := organization.FromContext(ctx)
+	s.log.Infof(
+		"received registration from cluster %v and instance %v of organization %v (role: %v, replication lag: %vs, server version: %v)",
+		request.ClusterName,
+		request.InstanceName,
+		org,
+		request.Role,
+		request.ReplicationLagSecs,
+		request.ServerVersionNum,
+	)
+
+	if request.Role != "" && request.Role != cache.RolePrimary && request.Role != cache.RoleStandby {
+		return &proto.RegisterResponse{}, fmt.Errorf("role %v is not valid, must be one of: %v, %v", request.Role, cache.RolePrimary, cache.RoleStandby)
+	}
 
 	if err := s.cacheClient.SetInstance(ctx, cache.Instance{
-		ClusterName:   request.ClusterName,
-		Name:          request.InstanceName,
-		Host:          request.InstanceHost,
-		CollectorHost: request.CollectorHost,
+		Organization:       org,
+		ClusterName:        request.ClusterName,
+		Name:               request.InstanceName,
+		Host:               request.InstanceHost,
+		CollectorHost:      request.CollectorHost,
+		Role:               request.Role,
+		ReplicationLagSecs: request.ReplicationLagSecs,
+		ServerVersionNum:   request.ServerVersionNum,
 	}); err != nil {
 		return &proto.RegisterResponse{}, fmt.Errorf("could not SetInstance in cache: %v", err)
 	}
@@ -1,8 +1,10 @@
 package enterprise
 
 import (
+	"postgres-explain/backend/admin"
 	"postgres-explain/backend/enterprise/activities"
 	"postgres-explain/backend/enterprise/analytics"
+	"postgres-explain/backend/enterprise/anomalies"
 	"postgres-explain/backend/enterprise/collector"
 	"postgres-explain/backend/enterprise/info"
 	"postgres-explain/backend/enterprise/query_explainer"
@@ -12,7 +14,9 @@ import (
 var EnterpriseModules = map[string]modules.Module{
 	query_explainer.ModuleName: &query_explainer.Module{},
 	collector.ModuleName:       &collector.Module{},
+	admin.ModuleName:           &admin.Module{},
 	info.ModuleName:            &info.Module{},
 	activities.ModuleName:      &activities.Module{},
 	analytics.ModuleName:       &analytics.Module{},
+	anomalies.ModuleName:       &anomalies.Module{},
 }
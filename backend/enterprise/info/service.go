@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"net"
 	"postgres-explain/backend/cache"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
 )
 
@@ -19,7 +20,7 @@ type Service struct {
 }
 
 func (aps *Service) GetClusterInstances(ctx context.Context, request *proto.GetClusterInstancesRequest) (*proto.GetClusterInstancesResponse, error) {
-	instances, err := aps.cacheClient.GetClusterInstances(ctx, request.ClusterName)
+	instances, err := aps.cacheClient.GetClusterInstances(ctx, organization.FromContext(ctx), request.ClusterName)
 	if err != nil {
 		return &proto.GetClusterInstancesResponse{}, fmt.Errorf("could not GetClusterInstances: %v", err)
 	}
@@ -32,12 +33,14 @@ func (aps *Service) GetClusterInstances(ctx context.Context, request *proto.GetC
 		}
 
 		instancesProto = append(instancesProto, &proto.Instance{
-			Id:          instance.Name,
-			Name:        instance.Name,
-			Hostname:    hostname,
-			Port:        port,
-			Status:      "",
-			StatusError: "",
+			Id:                 instance.Name,
+			Name:               instance.Name,
+			Hostname:           hostname,
+			Port:               port,
+			Status:             "",
+			StatusError:        "",
+			Role:               instance.Role,
+			ReplicationLagSecs: instance.ReplicationLagSecs,
 		})
 	}
 
@@ -45,7 +48,7 @@ func (aps *Service) GetClusterInstances(ctx context.Context, request *proto.GetC
 }
 
 func (aps *Service) GetClusters(ctx context.Context, in *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
-	clusters, err := aps.cacheClient.GetClusters(ctx)
+	clusters, err := aps.cacheClient.GetClusters(ctx, organization.FromContext(ctx))
 	if err != nil {
 		return &proto.GetClustersResponse{}, err
 	}
@@ -97,7 +100,7 @@ func (aps *Service) GetDatabases(ctx context.Context, in *proto.GetDatabasesRequ
 }
 
 func (aps *Service) connectToClient(ctx context.Context, clusterName string) (proto.CommandsClient, *grpc.ClientConn, string, error) {
-	clusterInstances, err := aps.cacheClient.GetClusterInstances(ctx, clusterName)
+	clusterInstances, err := aps.cacheClient.GetClusterInstances(ctx, organization.FromContext(ctx), clusterName)
 	if err != nil {
 		return nil, nil, "", fmt.Errorf("could not GetInstance: %v", err)
 	}
@@ -5,30 +5,111 @@ import (
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"postgres-explain/backend/cache"
+	"postgres-explain/backend/organization"
 	"postgres-explain/proto"
+	"regexp"
+	"strings"
+	"time"
 )
 
+// explainAttemptTimeout bounds the attempt on one instance when the call has no deadline
+const explainAttemptTimeout = 30 * time.Second
+
+// sqlStateRegexp matches the SQLSTATE code that the driver of the collector appends to the errors of PostgreSQL
+var sqlStateRegexp = regexp.MustCompile(`\(SQLSTATE ([0-9A-Z]{5})\)`)
+
+// replicaSQLStates are the SQLSTATE codes of the errors of PostgreSQL caused by running the plan request on a standby:
+// a write explained with ANALYZE, a query canceled by the replay of the WAL or a standby shutting down or starting up
+var replicaSQLStates = map[string]bool{
+	"25006": true, // read_only_sql_transaction
+	"40001": true, // serialization_failure, raised on a conflict with recovery
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// replicaSQLStateClasses are the classes of SQLSTATE codes of the errors caused by a standby which cannot be reached
+var replicaSQLStateClasses = []string{
+	"08", // connection_exception
+}
+
 type CommandsClient struct {
 	log         *logrus.Entry
 	cacheClient *cache.Client
 }
 
-func (c CommandsClient) Explain(ctx context.Context, clusterName, instanceName string, planRequest *proto.PlanRequest) (*proto.PlanResponse, error) {
-	client, conn, err := c.connectToClient(ctx, clusterName, instanceName)
+// Explain runs the plan request on the first reachable instance chosen by the routing policy,
+// it returns the plan together with the name of the instance that produced it.
+// Only the instances of the organization of the call are considered.
+func (c CommandsClient) Explain(ctx context.Context, clusterName string, routing Routing, planRequest *proto.PlanRequest) (*proto.PlanResponse, string, error) {
+	instances, err := c.cacheClient.GetClusterInstances(ctx, organization.FromContext(ctx), clusterName)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not GetClusterInstances: %v", err)
+	}
+
+	candidates, err := routing.Candidates(instances)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not find instances for cluster %v: %v", clusterName, err)
+	}
+
+	var lastErr error
+	for i, instance := range candidates {
+		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout(ctx, len(candidates)-i))
+		planResponse, err := c.explain(attemptCtx, instance, planRequest)
+		cancel()
+		if err == nil {
+			return planResponse, instance.Name, nil
+		}
+		if ctx.Err() != nil {
+			return nil, "", fmt.Errorf("could not explain on instance %v: %w", instance.Name, ctx.Err())
+		}
+
+		switch {
+		case isUnreachable(err):
+			c.log.Warnf("collector %v of instance %v is unreachable, trying the next one: %v", instance.CollectorHost, instance.Name, err)
+		case !instance.IsPrimary() && isReplicaError(err):
+			c.log.Warnf("instance %v cannot run the plan request as a standby, trying the next one: %v", instance.Name, err)
+		default:
+			return nil, "", err
+		}
+		lastErr = err
+	}
+
+	return nil, "", fmt.Errorf("all collectors for cluster %v failed: %v", clusterName, lastErr)
+}
+
+// attemptTimeout splits the time left before the deadline of the call between the remaining attempts,
+// so that an unreachable instance leaves time to the next ones
+func attemptTimeout(ctx context.Context, remainingAttempts int) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return explainAttemptTimeout
+	}
+
+	return time.Until(deadline) / time.Duration(remainingAttempts)
+}
+
+func (c CommandsClient) explain(ctx context.Context, instance cache.Instance, planRequest *proto.PlanRequest) (*proto.PlanResponse, error) {
+	client, conn, err := c.connectToClient(instance)
 	if err != nil {
 		return nil, fmt.Errorf("could not connectToClient: %v", err)
 	}
 
 	defer conn.Close()
 
+	planRequest.ClusterName = instance.ClusterName
+	planRequest.InstanceName = instance.Name
+
 	commandResponse, err := client.Command(ctx, &proto.CommandRequest{
 		ActionType: proto.ActionTypes_EXPLAIN,
 		Message:    &proto.CommandRequest_PlanRequest{PlanRequest: planRequest},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not run Command EXPLAIN: %v", err)
+		return nil, fmt.Errorf("could not run Command EXPLAIN: %w", err)
 	}
 
 	if commandResponse.ActionType == proto.ActionTypes_EXPLAIN {
@@ -38,18 +119,48 @@ func (c CommandsClient) Explain(ctx context.Context, clusterName, instanceName s
 	return nil, fmt.Errorf("could not retreive response for command EXPLAIN")
 }
 
-func (c CommandsClient) connectToClient(ctx context.Context, clusterName, instanceName string) (proto.CommandsClient, *grpc.ClientConn, error) {
-	instance, err := c.cacheClient.GetInstance(ctx, clusterName, instanceName)
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not GetInstance: %v", err)
-	}
-
+func (c CommandsClient) connectToClient(instance cache.Instance) (proto.CommandsClient, *grpc.ClientConn, error) {
 	c.log.Infof("connecting to collector host %v", instance.CollectorHost)
 
 	grpcConn, err := grpc.Dial(instance.CollectorHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not Dial collector %v: %v", instance.CollectorHost, err)
+		return nil, nil, fmt.Errorf("could not Dial collector %v: %w", instance.CollectorHost, err)
 	}
 
 	return proto.NewCommandsClient(grpcConn), grpcConn, nil
 }
+
+func isUnreachable(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded
+}
+
+// isReplicaError returns whether the error can be caused by running the plan request on a standby,
+// the primary may run it. The other errors, like an undefined table, are returned as is.
+func isReplicaError(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	match := sqlStateRegexp.FindStringSubmatch(s.Message())
+	if match == nil {
+		return false
+	}
+
+	sqlState := match[1]
+	if replicaSQLStates[sqlState] {
+		return true
+	}
+	for _, class := range replicaSQLStateClasses {
+		if strings.HasPrefix(sqlState, class) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+package query_explainer
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"postgres-explain/backend/cache"
+	"postgres-explain/backend/organization"
+	"postgres-explain/proto"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAttemptTimeout(t *testing.T) {
+	assert.Equal(t, explainAttemptTimeout, attemptTimeout(context.Background(), 2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	timeout := attemptTimeout(ctx, 2)
+	assert.LessOrEqual(t, timeout, 5*time.Second)
+	assert.Greater(t, timeout, 4*time.Second)
+}
+
+func TestIsReplicaError(t *testing.T) {
+	assert.True(t, isReplicaError(status.Error(codes.Unknown, "ERROR: cannot execute INSERT in a read-only transaction (SQLSTATE 25006)")))
+	assert.True(t, isReplicaError(status.Error(codes.Unknown, "ERROR: canceling statement due to conflict with recovery (SQLSTATE 40001)")))
+	assert.True(t, isReplicaError(status.Error(codes.Unknown, "FATAL: the database system is starting up (SQLSTATE 57P03)")))
+	assert.True(t, isReplicaError(status.Error(codes.Unknown, "FATAL: terminating connection due to administrator command (SQLSTATE 57P01)")))
+	assert.True(t, isReplicaError(status.Error(codes.Unknown, "failed to connect: server closed the connection unexpectedly (SQLSTATE 08006)")))
+	assert.False(t, isReplicaError(status.Error(codes.Unknown, "ERROR: syntax error at or near \"SELEC\" (SQLSTATE 42601)")))
+	assert.False(t, isReplicaError(status.Error(codes.Unknown, "ERROR: relation \"orders\" does not exist (SQLSTATE 42P01)")))
+	assert.False(t, isReplicaError(status.Error(codes.Unknown, "cannot execute INSERT in a read-only transaction")))
+	assert.False(t, isReplicaError(errors.New("cannot execute INSERT in a read-only transaction (SQLSTATE 25006)")))
+}
+
+// fakeCollector answers the EXPLAIN commands with the given error, or with a plan when there is none
+type fakeCollector struct {
+	proto.UnimplementedCommandsServer
+	err   error
+	calls atomic.Int32
+}
+
+func (f *fakeCollector) Command(ctx context.Context, in *proto.CommandRequest) (*proto.CommandResponse, error) {
+	f.calls.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &proto.CommandResponse{
+		ActionType: proto.ActionTypes_EXPLAIN,
+		Message:    &proto.CommandResponse_PlanResponse{PlanResponse: &proto.PlanResponse{}},
+	}, nil
+}
+
+// startFakeCollector serves the collector on a local port and returns its address
+func startFakeCollector(t *testing.T, collector *fakeCollector) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterCommandsServer(server, collector)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestCommandsClient_Explain(t *testing.T) {
+	tests := []struct {
+		name         string
+		standbyErr   error
+		wantInstance string
+		wantErr      bool
+		primaryCalls int32
+	}{
+		{
+			name:         "read-only error fails over to the primary",
+			standbyErr:   status.Error(codes.Unknown, "ERROR: cannot execute INSERT in a read-only transaction (SQLSTATE 25006)"),
+			wantInstance: "primary",
+			primaryCalls: 1,
+		},
+		{
+			name:         "undefined table is returned straight away",
+			standbyErr:   status.Error(codes.Unknown, "ERROR: relation \"orders\" does not exist (SQLSTATE 42P01)"),
+			wantErr:      true,
+			primaryCalls: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := organization.NewContext(context.Background(), "org")
+			standby, primary := &fakeCollector{err: tt.standbyErr}, &fakeCollector{}
+
+			cacheClient, err := cache.New(cache.Params{})
+			assert.NoError(t, err)
+			assert.NoError(t, cacheClient.SetInstance(ctx, cache.Instance{
+				Organization: "org", ClusterName: "cluster", Name: "standby", Role: cache.RoleStandby,
+				CollectorHost: startFakeCollector(t, standby),
+			}))
+			assert.NoError(t, cacheClient.SetInstance(ctx, cache.Instance{
+				Organization: "org", ClusterName: "cluster", Name: "primary", Role: cache.RolePrimary,
+				CollectorHost: startFakeCollector(t, primary),
+			}))
+
+			c := CommandsClient{log: &logrus.Entry{Logger: logrus.New()}, cacheClient: cacheClient}
+			_, instance, err := c.Explain(ctx, "cluster", Routing{Policy: RoutingPolicyPreferReplica}, &proto.PlanRequest{})
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "SQLSTATE 42P01")
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantInstance, instance)
+			}
+			assert.Equal(t, int32(1), standby.calls.Load())
+			assert.Equal(t, tt.primaryCalls, primary.calls.Load())
+		})
+	}
+}
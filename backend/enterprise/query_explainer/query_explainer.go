@@ -25,6 +25,10 @@ func (m *Module) Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider cr
 }
 
 func (m *Module) Init(initArgs modules.InitArgs) error {
+	if m.DB.DriverName() != modules.ClickHouseDriver {
+		return fmt.Errorf("module %v requires %v storage", ModuleName, modules.ClickHouseDriver)
+	}
+
 	repository := Repository{DB: m.DB, Log: m.Log}
 
 	commandsClient := CommandsClient{
@@ -36,7 +40,7 @@ func (m *Module) Init(initArgs modules.InitArgs) error {
 		log:            m.Log,
 		Repo:           repository,
 		CommandsClient: commandsClient,
-		ActivitiesRepo: activities.NewActivitiesRepository(m.DB),
+		ActivitiesRepo: activities.NewActivitiesRepository(m.DB, nil),
 	}
 
 	proto.RegisterQueryExplainerServer(initArgs.GrpcServer, &service)
@@ -46,3 +50,14 @@ func (m *Module) Init(initArgs modules.InitArgs) error {
 	m.Log.Infof("initialized")
 	return nil
 }
+
+func (m *Module) Tables() []modules.Table {
+	return []modules.Table{{
+		Name:      "plans",
+		SelectSQL: exportQueryPlans,
+		InsertSQL: insertQueryPlan,
+		NewRow: func() interface{} {
+			return &PlanEntity{}
+		},
+	}}
+}
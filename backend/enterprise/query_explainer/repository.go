@@ -27,11 +27,12 @@ SELECT 	id,
    period_start,
    optimization_id
 FROM plans
-WHERE id = :plan_id;`
+WHERE organization = :organization AND id = :plan_id;`
 
-func (ar Repository) GetQueryPlan(ctx context.Context, planID string) (PlanEntity, error) {
+func (ar Repository) GetQueryPlan(ctx context.Context, organization, planID string) (PlanEntity, error) {
 	queryArgs := map[string]interface{}{
-		"plan_id": planID,
+		"organization": organization,
+		"plan_id":      planID,
 	}
 	rows, err := ar.DB.NamedQueryContext(ctx, selectQueryPlan, queryArgs)
 	if err != nil {
@@ -55,9 +56,32 @@ func (ar Repository) GetQueryPlan(ctx context.Context, planID string) (PlanEntit
 	return planEntities[0], nil
 }
 
+// exportQueryPlans selects the plans of a cluster with the columns named after the parameters of insertQueryPlan
+const exportQueryPlans = `
+SELECT organization,
+       id,
+       alias,
+       query_fingerprint,
+       queryid,
+       plan,
+       original_plan,
+       query,
+       database,
+       username,
+       cluster,
+       period_start,
+       optimization_id
+FROM plans
+WHERE organization = :organization
+  AND cluster = :cluster_name
+  AND period_start >= :period_start_from
+  AND period_start <= :period_start_to
+ORDER BY period_start`
+
 const insertQueryPlan = `
   INSERT INTO plans
   (
+   organization,
 	id, 
    alias,
    query_fingerprint,
@@ -72,6 +96,7 @@ const insertQueryPlan = `
    optimization_id
    )
 VALUES (
+    :organization,
     :id,
 	:alias,
 	:query_fingerprint,
@@ -103,7 +128,7 @@ func (ar Repository) SaveQueryPlan(ctx context.Context, entity PlanEntity) error
 const getPlansTmpl = `
 SELECT id, alias, period_start, query, optimization_id, query_fingerprint 
 FROM plans 
-WHERE cluster = :cluster
+WHERE organization = :organization AND cluster = :cluster
 ORDER BY {{ .OrderBy}} {{ .OrderDir }} 
 LIMIT :limit
 `
@@ -115,7 +140,7 @@ func (ar Repository) GetPlansList(ctx context.Context, request PlansSearchReques
 const getOptimizationsTmpl = `
 SELECT id, alias, period_start, query, optimization_id, query_fingerprint, plan
 FROM plans 
-WHERE cluster = :cluster AND (query_fingerprint = :query_fingerprint OR optimization_id = :optimization_id)
+WHERE organization = :organization AND cluster = :cluster AND (query_fingerprint = :query_fingerprint OR optimization_id = :optimization_id)
 ORDER BY {{ .OrderBy}} {{ .OrderDir }} 
 LIMIT :limit
 `
@@ -0,0 +1,119 @@
+package query_explainer
+
+import (
+	"fmt"
+	"postgres-explain/backend/cache"
+	"sort"
+)
+
+const (
+	// RoutingPolicyInstance runs the command only on the instance named in the request
+	RoutingPolicyInstance = "instance"
+	// RoutingPolicyPrimary runs the command only on the primary instance
+	RoutingPolicyPrimary = "primary"
+	// RoutingPolicyPreferReplica runs the command on the least lagging standby,
+	// falling back to the primary if no standby is reachable
+	RoutingPolicyPreferReplica = "prefer_replica"
+)
+
+type Routing struct {
+	Policy                string
+	InstanceName          string
+	MaxReplicationLagSecs float32
+}
+
+func NewRouting(policy, instanceName string, maxReplicationLagSecs float32) (Routing, error) {
+	if policy == "" {
+		if instanceName != "" {
+			policy = RoutingPolicyInstance
+		} else {
+			policy = RoutingPolicyPreferReplica
+		}
+	}
+
+	switch policy {
+	case RoutingPolicyInstance:
+		if instanceName == "" {
+			return Routing{}, fmt.Errorf("instance_name is required with routing policy %v", RoutingPolicyInstance)
+		}
+	case RoutingPolicyPrimary, RoutingPolicyPreferReplica:
+	default:
+		return Routing{}, fmt.Errorf(
+			"routing policy %v is not valid, must be one of: %v, %v, %v",
+			policy,
+			RoutingPolicyInstance,
+			RoutingPolicyPrimary,
+			RoutingPolicyPreferReplica,
+		)
+	}
+
+	return Routing{
+		Policy:                policy,
+		InstanceName:          instanceName,
+		MaxReplicationLagSecs: maxReplicationLagSecs,
+	}, nil
+}
+
+// Candidates returns the instances that can run the command, in the order they should be tried.
+// The next instance is used only if the collector of the previous one is unreachable.
+func (r Routing) Candidates(instances map[string]cache.Instance) ([]cache.Instance, error) {
+	candidates := make([]cache.Instance, 0)
+
+	switch r.Policy {
+	case RoutingPolicyInstance:
+		if instance, ok := instances[r.InstanceName]; ok {
+			candidates = append(candidates, instance)
+		}
+	case RoutingPolicyPrimary:
+		for _, instance := range sortedByName(instances) {
+			if instance.IsPrimary() {
+				candidates = append(candidates, instance)
+			}
+		}
+	case RoutingPolicyPreferReplica:
+		standbys := make([]cache.Instance, 0)
+		primaries := make([]cache.Instance, 0)
+		unknown := make([]cache.Instance, 0)
+		for _, instance := range sortedByName(instances) {
+			switch {
+			case instance.IsStandby():
+				if r.MaxReplicationLagSecs > 0 && instance.ReplicationLagSecs >= r.MaxReplicationLagSecs {
+					continue
+				}
+				standbys = append(standbys, instance)
+			case instance.IsPrimary():
+				primaries = append(primaries, instance)
+			default:
+				// Collectors that do not report the role, we don't know if they are lagging
+				unknown = append(unknown, instance)
+			}
+		}
+
+		sort.SliceStable(standbys, func(i, j int) bool {
+			return standbys[i].ReplicationLagSecs < standbys[j].ReplicationLagSecs
+		})
+
+		candidates = append(candidates, standbys...)
+		candidates = append(candidates, primaries...)
+		candidates = append(candidates, unknown...)
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no instance found for routing policy %v", r.Policy)
+	}
+
+	return candidates, nil
+}
+
+func sortedByName(instances map[string]cache.Instance) []cache.Instance {
+	sorted := make([]cache.Instance, 0, len(instances))
+	for _, instance := range instances {
+		sorted = append(sorted, instance)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
@@ -0,0 +1,85 @@
+package query_explainer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"postgres-explain/backend/cache"
+	"testing"
+)
+
+func TestRouting_Candidates(t *testing.T) {
+	instances := map[string]cache.Instance{
+		"primary":   {Name: "primary", Role: cache.RolePrimary},
+		"replica-1": {Name: "replica-1", Role: cache.RoleStandby, ReplicationLagSecs: 12},
+		"replica-2": {Name: "replica-2", Role: cache.RoleStandby, ReplicationLagSecs: 0.5},
+		"old":       {Name: "old"},
+	}
+
+	names := func(instances []cache.Instance) []string {
+		n := make([]string, 0)
+		for _, instance := range instances {
+			n = append(n, instance.Name)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name    string
+		routing Routing
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "specific instance",
+			routing: Routing{Policy: RoutingPolicyInstance, InstanceName: "replica-1"},
+			want:    []string{"replica-1"},
+		},
+		{
+			name:    "specific instance not registered",
+			routing: Routing{Policy: RoutingPolicyInstance, InstanceName: "missing"},
+			wantErr: true,
+		},
+		{
+			name:    "primary only",
+			routing: Routing{Policy: RoutingPolicyPrimary},
+			want:    []string{"primary"},
+		},
+		{
+			name:    "prefer replica ordered by lag",
+			routing: Routing{Policy: RoutingPolicyPreferReplica},
+			want:    []string{"replica-2", "replica-1", "primary", "old"},
+		},
+		{
+			name:    "prefer replica skips lagging standbys",
+			routing: Routing{Policy: RoutingPolicyPreferReplica, MaxReplicationLagSecs: 10},
+			want:    []string{"replica-2", "primary", "old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.routing.Candidates(instances)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, names(got))
+		})
+	}
+}
+
+func TestNewRouting(t *testing.T) {
+	routing, err := NewRouting("", "replica-1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, RoutingPolicyInstance, routing.Policy)
+
+	routing, err = NewRouting("", "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, RoutingPolicyPreferReplica, routing.Policy)
+
+	_, err = NewRouting(RoutingPolicyInstance, "", 0)
+	assert.Error(t, err)
+
+	_, err = NewRouting("random", "", 0)
+	assert.Error(t, err)
+}
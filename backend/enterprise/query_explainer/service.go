@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"postgres-explain/backend/enterprise/activities"
+	"postgres-explain/backend/organization"
 	"postgres-explain/backend/shared"
 	"postgres-explain/core/pkg"
 	"postgres-explain/proto"
@@ -26,6 +27,7 @@ type Service struct {
 
 func (aps *Service) GetOptimizationsList(ctx context.Context, request *proto.GetOptimizationsListRequest) (*proto.GetOptimizationsListResponse, error) {
 	list, err := aps.Repo.GetOptimizations(ctx, PlansSearchRequest{
+		Organization:     organization.FromContext(ctx),
 		PeriodStartFrom:  request.PeriodStartFrom.AsTime(),
 		PeriodStartTo:    request.PeriodStartTo.AsTime(),
 		ClusterName:      request.ClusterName,
@@ -62,6 +64,7 @@ func (aps *Service) GetOptimizationsList(ctx context.Context, request *proto.Get
 
 func (aps *Service) GetQueryPlansList(ctx context.Context, request *proto.GetQueryPlansListRequest) (*proto.GetQueryPlansListResponse, error) {
 	list, err := aps.Repo.GetPlansList(ctx, PlansSearchRequest{
+		Organization:    organization.FromContext(ctx),
 		PeriodStartFrom: request.PeriodStartFrom.AsTime(),
 		PeriodStartTo:   request.PeriodStartTo.AsTime(),
 		ClusterName:     request.ClusterName,
@@ -95,7 +98,12 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 		return nil, fmt.Errorf("could not makePlanRequest: %v", err)
 	}
 
-	plan, err := aps.CommandsClient.Explain(ctx, request.ClusterName, request.InstanceName, planRequest)
+	routing, err := NewRouting(request.RoutingPolicy, request.InstanceName, request.MaxReplicationLagSecs)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %v", err)
+	}
+
+	plan, instanceName, err := aps.CommandsClient.Explain(ctx, request.ClusterName, routing, planRequest)
 	if err != nil {
 		return nil, fmt.Errorf("could not run explain: %v", err)
 	}
@@ -124,6 +132,7 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 	}
 
 	planEntity := PlanEntity{
+		Organization:     organization.FromContext(ctx),
 		Alias:            shared.ToSqlNullString(request.Alias),
 		Query:            planRequest.Query,
 		PlanID:           planId,
@@ -147,11 +156,11 @@ func (aps *Service) SaveQueryPlan(ctx context.Context, request *proto.SaveQueryP
 		return nil, fmt.Errorf("could not SaveQueryPlan: %v", err)
 	}
 
-	return &proto.SaveQueryPlanResponse{PlanId: planEntity.PlanID}, nil
+	return &proto.SaveQueryPlanResponse{PlanId: planEntity.PlanID, InstanceName: instanceName}, nil
 }
 
 func (aps *Service) GetQueryPlan(ctx context.Context, request *proto.GetQueryPlanRequest) (*proto.GetQueryPlanResponse, error) {
-	plan, err := aps.Repo.GetQueryPlan(ctx, request.PlanId)
+	plan, err := aps.Repo.GetQueryPlan(ctx, organization.FromContext(ctx), request.PlanId)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +217,7 @@ func (aps *Service) makePlanRequest(ctx context.Context, request *proto.SaveQuer
 	}
 
 	if request.QueryFingerprint != "" {
-		queryMetadata, err := aps.ActivitiesRepo.GetQueryMetadataByFingerprint(ctx, request.QueryFingerprint)
+		queryMetadata, err := aps.ActivitiesRepo.GetQueryMetadataByFingerprint(ctx, organization.FromContext(ctx), request.QueryFingerprint)
 		if err != nil {
 			return nil, fmt.Errorf("could not GetQueryMetadataByFingerprint: %v", err)
 		}
@@ -233,7 +242,7 @@ func (aps *Service) makePlanRequest(ctx context.Context, request *proto.SaveQuer
 	}
 
 	if request.QuerySha != "" {
-		queryMetadata, err := aps.ActivitiesRepo.GetQueryMetadataBySha(ctx, request.QuerySha)
+		queryMetadata, err := aps.ActivitiesRepo.GetQueryMetadataBySha(ctx, organization.FromContext(ctx), request.QuerySha)
 		if err != nil {
 			return nil, fmt.Errorf("could not GetQueryMetadataBySha: %v", err)
 		}
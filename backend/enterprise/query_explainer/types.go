@@ -6,6 +6,7 @@ import (
 )
 
 type PlanEntity struct {
+	Organization     string         `json:"organization"`
 	PlanID           string         `json:"id"`
 	OptimizationId   string         `json:"optimization_id"`
 	Alias            sql.NullString `json:"alias"`
@@ -21,7 +22,16 @@ type PlanEntity struct {
 	Username         string         `json:"username"`
 }
 
+func (p *PlanEntity) GetOrganization() string {
+	return p.Organization
+}
+
+func (p *PlanEntity) SetOrganization(organization string) {
+	p.Organization = organization
+}
+
 type PlansSearchRequest struct {
+	Organization     string    `json:"organization"`
 	PeriodStartFrom  time.Time `json:"period_start_from"`
 	PeriodStartTo    time.Time `json:"period_start_to"`
 	ClusterName      string    `json:"cluster_name"`
@@ -37,6 +47,7 @@ func (r PlansSearchRequest) ToQueryArgs() map[string]interface{} {
 	}
 
 	m := map[string]interface{}{
+		"organization":      r.Organization,
 		"cluster":           r.ClusterName,
 		"limit":             r.Limit,
 		"query_fingerprint": r.QueryFingerprint,
@@ -14,21 +14,28 @@ var commonColumnNames = map[string]struct{}{
 }
 
 var sumColumnNames = map[string]struct{}{
-	"shared_blks_hit":     {},
-	"shared_blks_read":    {},
-	"shared_blks_dirtied": {},
-	"shared_blks_written": {},
-	"local_blks_hit":      {},
-	"local_blks_read":     {},
-	"local_blks_dirtied":  {},
-	"local_blks_written":  {},
-	"temp_blks_read":      {},
-	"temp_blks_written":   {},
-	"blk_read_time":       {},
-	"blk_write_time":      {},
-	"plans_calls":         {},
-	"wal_records":         {},
-	"wal_fpi":             {},
-	"plan_time":           {},
-	"wal_bytes":           {},
+	"shared_blks_hit":       {},
+	"shared_blks_read":      {},
+	"shared_blks_dirtied":   {},
+	"shared_blks_written":   {},
+	"local_blks_hit":        {},
+	"local_blks_read":       {},
+	"local_blks_dirtied":    {},
+	"local_blks_written":    {},
+	"temp_blks_read":        {},
+	"temp_blks_written":     {},
+	"blk_read_time":         {},
+	"blk_write_time":        {},
+	"plans_calls":           {},
+	"wal_records":           {},
+	"wal_fpi":               {},
+	"plan_time":             {},
+	"wal_bytes":             {},
+	"cpu_user_time":         {},
+	"cpu_sys_time":          {},
+	"jit_functions":         {},
+	"jit_generation_time":   {},
+	"jit_inlining_time":     {},
+	"jit_optimization_time": {},
+	"jit_emission_time":     {},
 }
@@ -0,0 +1,152 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"sync"
+	"time"
+)
+
+const (
+	// DeduplicationWindow is how long the batch ids of the collectors are remembered, the requests retried later
+	// are inserted again and deduplicated by ClickHouse with the token of DeduplicationContext
+	DeduplicationWindow = time.Hour
+	// maxDeduplicatedBatches bounds the number of batch ids remembered, the oldest ones are forgotten first
+	maxDeduplicatedBatches = 100000
+)
+
+// batchKey returns the key of a batch id, the collectors of the organizations generate their batch ids independently
+func batchKey(organization, batchID string) string {
+	return fmt.Sprintf("%v:%v", organization, batchID)
+}
+
+// DeduplicationContext returns the context of the INSERT of the rows of a collector request. ClickHouse stores
+// the blocks of a deduplication token once, in the table and in the rollups of its materialized views,
+// within the non_replicated_deduplication_window of the tables, so each request must be a separate INSERT.
+// The requests without a batch id are always stored.
+func DeduplicationContext(ctx context.Context, organization, batchID string) context.Context {
+	if batchID == "" {
+		return ctx
+	}
+
+	return clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{
+		"insert_deduplication_token":                         batchKey(organization, batchID),
+		"deduplicate_blocks_in_dependent_materialized_views": 1,
+	}))
+}
+
+type claimedBatch struct {
+	key       string
+	claimedAt time.Time
+}
+
+// BatchState is the state of the batch id of a request received by a collector
+type BatchState int
+
+const (
+	// BatchNew is a batch id not received within the window, the request must be stored
+	BatchNew BatchState = iota
+	// BatchPending is a batch id whose first request is being stored, the retry must wait for its result
+	BatchPending
+	// BatchStored is a batch id whose request was stored, the retry must be acknowledged without storing it
+	BatchStored
+)
+
+type batchClaim struct {
+	claimedAt time.Time
+	stored    bool
+}
+
+// BatchDeduplicator remembers the batch ids of the requests received recently,
+// so that the requests retried by the collectors are stored once
+type BatchDeduplicator struct {
+	window     time.Duration
+	maxBatches int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	batches map[string]batchClaim
+	// order holds the claims by time, it can contain released batches which are skipped when forgotten
+	order []claimedBatch
+}
+
+func NewBatchDeduplicator(window time.Duration, maxBatches int) *BatchDeduplicator {
+	if maxBatches <= 0 {
+		maxBatches = maxDeduplicatedBatches
+	}
+
+	return &BatchDeduplicator{
+		window:     window,
+		maxBatches: maxBatches,
+		now:        time.Now,
+		batches:    make(map[string]batchClaim),
+	}
+}
+
+// Claim returns the state of the batch id of a request, a new batch id is claimed by the request
+// until it is Stored or Released. The requests without a batch id are always new.
+func (d *BatchDeduplicator) Claim(organization, batchID string) BatchState {
+	if batchID == "" {
+		return BatchNew
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.forget(now)
+
+	key := batchKey(organization, batchID)
+	if claim, ok := d.batches[key]; ok {
+		if claim.stored {
+			return BatchStored
+		}
+		return BatchPending
+	}
+	d.batches[key] = batchClaim{claimedAt: now}
+	d.order = append(d.order, claimedBatch{key: key, claimedAt: now})
+
+	return BatchNew
+}
+
+// Stored marks a claimed batch id as stored, its retries are acknowledged without being stored
+func (d *BatchDeduplicator) Stored(organization, batchID string) {
+	if batchID == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := batchKey(organization, batchID)
+	if claim, ok := d.batches[key]; ok {
+		claim.stored = true
+		d.batches[key] = claim
+	}
+}
+
+// Release forgets a claimed batch id whose request could not be stored, so that it is stored when retried
+func (d *BatchDeduplicator) Release(organization, batchID string) {
+	if batchID == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.batches, batchKey(organization, batchID))
+}
+
+// forget removes the batches claimed before the window and the oldest ones above maxBatches
+func (d *BatchDeduplicator) forget(now time.Time) {
+	forgotten := 0
+	for _, batch := range d.order {
+		if now.Sub(batch.claimedAt) < d.window && len(d.order)-forgotten < d.maxBatches {
+			break
+		}
+		if claim, ok := d.batches[batch.key]; ok && claim.claimedAt.Equal(batch.claimedAt) {
+			delete(d.batches, batch.key)
+		}
+		forgotten++
+	}
+	d.order = d.order[forgotten:]
+}
@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBatchDeduplicator_Claim(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deduplicator := NewBatchDeduplicator(time.Hour, 2)
+	deduplicator.now = func() time.Time { return now }
+
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", "batch-1"))
+	assert.Equal(t, BatchPending, deduplicator.Claim("org", "batch-1"), "retried while the batch is being stored")
+	deduplicator.Stored("org", "batch-1")
+	assert.Equal(t, BatchStored, deduplicator.Claim("org", "batch-1"), "retried batch")
+	assert.Equal(t, BatchNew, deduplicator.Claim("other", "batch-1"), "batch of another organization")
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", ""), "batch without id")
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", ""), "batch without id")
+
+	// the oldest batch is forgotten above the maximum number of batches
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", "batch-2"))
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", "batch-1"))
+
+	// a released batch is stored when retried
+	deduplicator.Release("org", "batch-2")
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", "batch-2"))
+
+	// the batches are forgotten after the window
+	now = now.Add(time.Hour)
+	assert.Equal(t, BatchNew, deduplicator.Claim("org", "batch-1"))
+	assert.Len(t, deduplicator.batches, 1)
+}
+
+func TestBatchKey(t *testing.T) {
+	assert.Equal(t, "org:batch-1", batchKey("org", "batch-1"))
+}
@@ -22,7 +22,30 @@ func NewMetricsRepository(db *sqlx.DB) MetricsRepository {
 	return MetricsRepository{db: db}
 }
 
+var analyticsSource = Source{Table: "analytics", TimeColumn: "period_start", Step: time.Minute}
+
+var analyticsRollups = []Source{
+	{Table: "analytics_1m", TimeColumn: "bucket_start", Step: time.Minute, IsRollup: true},
+	{Table: "analytics_10m", TimeColumn: "bucket_start", Step: 10 * time.Minute, IsRollup: true},
+	{Table: "analytics_1h", TimeColumn: "bucket_start", Step: time.Hour, IsRollup: true},
+}
+
+// analyticsRollupsDimensions are the columns kept by the rollups, filtering or grouping
+// by any other column (or by labels) needs the raw table.
+var analyticsRollupsDimensions = map[string]struct{}{
+	"organization":  {},
+	"cluster_name":  {},
+	"instance_name": {},
+	"queryid":       {},
+	"fingerprint":   {},
+	"database":      {},
+	"schema":        {},
+	"username":      {},
+	"client_host":   {},
+}
+
 type MetricsGetArgs struct {
+	Organization                         string
 	PeriodStartFromSec, PeriodStartToSec int64
 	Filter, Group                        string
 	Dimensions, Labels                   map[string][]string
@@ -40,13 +63,13 @@ SUM(m_query_time_cnt) AS m_query_time_cnt,
 SUM(m_query_time_sum) AS m_query_time_sum,
 MIN(m_query_time_min) AS m_query_time_min,
 MAX(m_query_time_max) AS m_query_time_max,
-AVG(m_query_time_p99) AS m_query_time_p99,
+{{ if .IsRollup }}avgMerge(m_query_time_p99){{ else }}AVG(m_query_time_p99){{ end }} AS m_query_time_p99,
 
 SUM(m_rows_sent_cnt) AS m_rows_sent_cnt,
 SUM(m_rows_sent_sum) AS m_rows_sent_sum,
 MIN(m_rows_sent_min) AS m_rows_sent_min,
 MAX(m_rows_sent_max) AS m_rows_sent_max,
-AVG(m_rows_sent_p99) AS m_rows_sent_p99,
+{{ if .IsRollup }}avgMerge(m_rows_sent_p99){{ else }}AVG(m_rows_sent_p99){{ end }} AS m_rows_sent_p99,
 
 SUM(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
 SUM(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
@@ -61,10 +84,27 @@ SUM(m_local_blks_written_sum) AS m_local_blks_written_sum,
 SUM(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
 SUM(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
 SUM(m_blk_read_time_sum) AS m_blk_read_time_sum,
-SUM(m_blk_write_time_sum) AS m_blk_write_time_sum
+SUM(m_blk_write_time_sum) AS m_blk_write_time_sum,
 
-FROM analytics
-WHERE period_start >= :period_start_from AND period_start <= :period_start_to
+SUM(m_cpu_user_time_sum) AS m_cpu_user_time_sum,
+SUM(m_cpu_sys_time_sum) AS m_cpu_sys_time_sum,
+
+SUM(m_plans_calls_sum) AS m_plans_calls_sum,
+SUM(m_plan_time_sum) AS m_plan_time_sum,
+
+SUM(m_wal_records_sum) AS m_wal_records_sum,
+SUM(m_wal_fpi_sum) AS m_wal_fpi_sum,
+SUM(m_wal_bytes_sum) AS m_wal_bytes_sum,
+
+SUM(m_jit_functions_sum) AS m_jit_functions_sum,
+SUM(m_jit_generation_time_sum) AS m_jit_generation_time_sum,
+SUM(m_jit_inlining_time_sum) AS m_jit_inlining_time_sum,
+SUM(m_jit_optimization_time_sum) AS m_jit_optimization_time_sum,
+SUM(m_jit_emission_time_sum) AS m_jit_emission_time_sum
+
+FROM {{ .Table }}
+WHERE {{ .TimeColumn }} >= :period_start_from AND {{ .TimeColumn }} <= :period_start_to
+AND organization = :organization
 {{ if not .Totals }} AND {{ .Group }} = '{{ .DimensionVal }}' {{ end }}
 {{ if .Dimensions }}
     {{range $key, $vals := .Dimensions }}
@@ -80,6 +120,22 @@ WHERE period_start >= :period_start_from AND period_start <= :period_start_to
 	WITH TOTALS;
 `
 
+func (gArgs MetricsGetArgs) source() Source {
+	if len(gArgs.Labels) > 0 {
+		return analyticsSource
+	}
+	if _, ok := analyticsRollupsDimensions[gArgs.Group]; !ok && !gArgs.Totals {
+		return analyticsSource
+	}
+	for dimension := range gArgs.Dimensions {
+		if _, ok := analyticsRollupsDimensions[dimension]; !ok {
+			return analyticsSource
+		}
+	}
+
+	return PickSource(analyticsSource, analyticsRollups, gArgs.PeriodStartFromSec, gArgs.PeriodStartToSec)
+}
+
 // Get select metrics for specific queryid, hostname, etc.
 // If totals = true, the function will return only totals and it will skip filters
 // to differentiate it from empty filters.
@@ -87,9 +143,11 @@ func (m *MetricsRepository) Get(ctx context.Context, gArgs MetricsGetArgs) ([]M,
 	arg := map[string]interface{}{
 		"period_start_from": gArgs.PeriodStartFromSec,
 		"period_start_to":   gArgs.PeriodStartToSec,
+		"organization":      gArgs.Organization,
 	}
 
 	tmplArgs := struct {
+		Source
 		PeriodStartFrom int64
 		PeriodStartTo   int64
 		PeriodDuration  int64
@@ -99,6 +157,7 @@ func (m *MetricsRepository) Get(ctx context.Context, gArgs MetricsGetArgs) ([]M,
 		Group           string
 		Totals          bool
 	}{
+		Source:          gArgs.source(),
 		PeriodStartFrom: gArgs.PeriodStartFromSec,
 		PeriodStartTo:   gArgs.PeriodStartToSec,
 		PeriodDuration:  gArgs.PeriodStartToSec - gArgs.PeriodStartFromSec,
@@ -156,16 +215,17 @@ func (m *MetricsRepository) Get(ctx context.Context, gArgs MetricsGetArgs) ([]M,
 }
 
 const queryMetricsTimeseries = `
-SELECT num_queries,
-       (m_query_time_sum / num_queries) AS m_query_time_avg_per_call,
-       m_rows_sent_sum,
-       m_shared_blks_read_sum + m_local_blks_read_sum + m_temp_blks_read_sum AS m_total_blks_read_sum,
-       m_shared_blks_written_sum + m_local_blks_written_sum + m_temp_blks_written_sum AS m_total_blks_written_sum,
-       m_shared_blks_hit_sum + m_local_blks_hit_sum AS m_total_blks_hit_sum,
-       period_start
-FROM analytics
-WHERE period_start >= :period_start_from AND period_start <= :period_start_to AND fingerprint = :fingerprint AND cluster_name = :cluster_name
-ORDER BY period_start;
+SELECT SUM(num_queries) AS num_queries,
+       (SUM(m_query_time_sum) / SUM(num_queries)) AS m_query_time_avg_per_call,
+       SUM(m_rows_sent_sum) AS m_rows_sent_sum,
+       SUM(m_shared_blks_read_sum + m_local_blks_read_sum + m_temp_blks_read_sum) AS m_total_blks_read_sum,
+       SUM(m_shared_blks_written_sum + m_local_blks_written_sum + m_temp_blks_written_sum) AS m_total_blks_written_sum,
+       SUM(m_shared_blks_hit_sum + m_local_blks_hit_sum) AS m_total_blks_hit_sum,
+       toStartOfInterval({{ .TimeColumn }}, INTERVAL {{ .StepSec }} SECOND) AS slot
+FROM {{ .Table }}
+WHERE {{ .TimeColumn }} >= :period_start_from AND {{ .TimeColumn }} <= :period_start_to AND organization = :organization AND fingerprint = :fingerprint AND cluster_name = :cluster_name
+GROUP BY slot
+ORDER BY slot;
 `
 
 func (m *MetricsRepository) SelectQueryMetricsByFingerprint(
@@ -179,13 +239,20 @@ func (m *MetricsRepository) SelectQueryMetricsByFingerprint(
 		"period_start_to":   in.PeriodStartToSec,
 		"fingerprint":       queryFingerprint,
 		"cluster_name":      clusterName,
+		"organization":      in.Organization,
 	}
 
-	var queryBuffer bytes.Buffer
-	queryBuffer.WriteString(queryMetricsTimeseries)
+	source := PickSource(analyticsSource, analyticsRollups, in.PeriodStartFromSec, in.PeriodStartToSec)
+	tmplArgs := struct {
+		Source
+		StepSec int64
+	}{
+		Source:  source,
+		StepSec: source.StepSec(),
+	}
 
 	var results []QueryMetricDB
-	query, args, err := processQuery(queryBuffer, arg)
+	query, args, err := ProcessQueryWithTemplate(tmplArgs, arg, queryMetricsTimeseries)
 	if err != nil {
 		return nil, fmt.Errorf("could not process query: %v", err)
 	}
@@ -213,7 +280,7 @@ func (m *MetricsRepository) SelectQueryMetricsByFingerprint(
 }
 
 type QueryMetricDB struct {
-	Timestamp           time.Time `json:"period_start"`
+	Timestamp           time.Time `json:"slot"`
 	QueryTimeAvgPerCall float64   `json:"m_query_time_avg_per_call"`
 	NumQueries          float64   `json:"num_queries"`
 	RowSent             float64   `json:"m_rows_sent_sum"`
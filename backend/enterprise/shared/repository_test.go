@@ -10,6 +10,7 @@ import (
 func TestMetricsRepository_Get(t *testing.T) {
 	t.Run("query tmpl", func(t *testing.T) {
 		tmplArgs := struct {
+			Source
 			PeriodStartFrom int64
 			PeriodStartTo   int64
 			PeriodDuration  int64
@@ -19,6 +20,7 @@ func TestMetricsRepository_Get(t *testing.T) {
 			Group           string
 			Totals          bool
 		}{
+			Source:          analyticsSource,
 			PeriodStartFrom: 100000000,
 			PeriodStartTo:   100000000,
 			PeriodDuration:  100,
@@ -0,0 +1,101 @@
+package shared
+
+import "time"
+
+// MinRollupPoints is the minimum number of buckets a rollup must give for the requested range,
+// below that the chart would be too coarse and a finer source is used.
+const MinRollupPoints = 100
+
+// Source is the table the data is read from, either the raw table or one of its rollups.
+type Source struct {
+	Table string
+	// TimeColumn contains the start of the period (raw table) or of the bucket (rollups)
+	TimeColumn string
+	// Step is the width of the rollup buckets, for the raw table is the width used to group the rows
+	Step     time.Duration
+	IsRollup bool
+}
+
+func (s Source) StepSec() int64 {
+	return int64(s.Step / time.Second)
+}
+
+// PickSource returns the coarsest rollup which still gives at least MinRollupPoints buckets
+// for the requested range, short ranges are read from the raw table.
+// Rollups must be ordered from the finest to the coarsest.
+func PickSource(raw Source, rollups []Source, periodStartFromSec, periodStartToSec int64) Source {
+	duration := time.Duration(periodStartToSec-periodStartFromSec) * time.Second
+	for i := len(rollups) - 1; i >= 0; i-- {
+		if duration/rollups[i].Step >= MinRollupPoints {
+			return rollups[i]
+		}
+	}
+
+	return raw
+}
+
+// MaxStepPoints is the maximum number of buckets of an automatic step
+const MaxStepPoints = 360
+
+// MaxRequestedStepPoints is the maximum number of buckets of a step, requested or automatic
+const MaxRequestedStepPoints = 10 * MaxStepPoints
+
+// steps are the automatic steps, from the finest to the coarsest
+var steps = []time.Duration{
+	time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+}
+
+// AutoStep returns the finest step giving at most MaxStepPoints buckets for the requested range, at most one hour.
+func AutoStep(periodStartFromSec, periodStartToSec int64) time.Duration {
+	duration := time.Duration(periodStartToSec-periodStartFromSec) * time.Second
+	for _, step := range steps {
+		if duration/step <= MaxStepPoints {
+			return step
+		}
+	}
+
+	return steps[len(steps)-1]
+}
+
+// covers returns whether a table still holds the data of a period starting at periodStartFromSec,
+// retentionDays is the number of days of data kept for each table, the missing ones are kept forever
+func covers(table string, periodStartFromSec int64, retentionDays map[string]uint, now time.Time) bool {
+	days := retentionDays[table]
+	return days == 0 || periodStartFromSec >= now.AddDate(0, 0, -int(days)).Unix()
+}
+
+// PickSourceForStep returns the coarsest rollup covering the period whose buckets can be grouped into buckets of the given step,
+// the raw table is used for steps finer than the rollups. The Step of the returned source is the given step,
+// unless the raw table does not cover the period anymore: the step is then rounded up to the buckets of the finest rollup covering it.
+// Rollups must be ordered from the finest to the coarsest.
+func PickSourceForStep(raw Source, rollups []Source, step time.Duration, periodStartFromSec int64, retentionDays map[string]uint, now time.Time) Source {
+	for i := len(rollups) - 1; i >= 0; i-- {
+		if step%rollups[i].Step == 0 && covers(rollups[i].Table, periodStartFromSec, retentionDays, now) {
+			source := rollups[i]
+			source.Step = step
+			return source
+		}
+	}
+
+	if !covers(raw.Table, periodStartFromSec, retentionDays, now) {
+		for _, rollup := range rollups {
+			if covers(rollup.Table, periodStartFromSec, retentionDays, now) {
+				source := rollup
+				source.Step = (step + rollup.Step - 1) / rollup.Step * rollup.Step
+				return source
+			}
+		}
+	}
+
+	source := raw
+	source.Step = step
+	return source
+}
@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPickSource(t *testing.T) {
+	raw := Source{Table: "activities", TimeColumn: "period_start", Step: time.Minute}
+	rollups := []Source{
+		{Table: "activities_1m", TimeColumn: "bucket_start", Step: time.Minute, IsRollup: true},
+		{Table: "activities_10m", TimeColumn: "bucket_start", Step: 10 * time.Minute, IsRollup: true},
+		{Table: "activities_1h", TimeColumn: "bucket_start", Step: time.Hour, IsRollup: true},
+	}
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		want     string
+	}{
+		{name: "last hour", duration: time.Hour, want: "activities"},
+		{name: "last 6 hours", duration: 6 * time.Hour, want: "activities_1m"},
+		{name: "last day", duration: 24 * time.Hour, want: "activities_10m"},
+		{name: "last 30 days", duration: 30 * 24 * time.Hour, want: "activities_1h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := time.Now().Unix()
+			from := to - int64(tt.duration/time.Second)
+			assert.Equal(t, tt.want, PickSource(raw, rollups, from, to).Table)
+		})
+	}
+}
+
+func TestAutoStep(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     time.Duration
+	}{
+		{duration: 5 * time.Minute, want: time.Second},
+		{duration: time.Hour, want: 10 * time.Second},
+		{duration: 24 * time.Hour, want: 5 * time.Minute},
+		{duration: 90 * 24 * time.Hour, want: time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.duration.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, AutoStep(0, int64(tt.duration/time.Second)))
+		})
+	}
+}
+
+func TestPickSourceForStep(t *testing.T) {
+	raw := Source{Table: "activities", TimeColumn: "period_start", Step: time.Minute}
+	rollups := []Source{
+		{Table: "activities_1m", TimeColumn: "bucket_start", Step: time.Minute, IsRollup: true},
+		{Table: "activities_10m", TimeColumn: "bucket_start", Step: 10 * time.Minute, IsRollup: true},
+		{Table: "activities_1h", TimeColumn: "bucket_start", Step: time.Hour, IsRollup: true},
+	}
+
+	tests := []struct {
+		step time.Duration
+		want string
+	}{
+		{step: 10 * time.Second, want: "activities"},
+		{step: 90 * time.Second, want: "activities"},
+		{step: 5 * time.Minute, want: "activities_1m"},
+		{step: 30 * time.Minute, want: "activities_10m"},
+		{step: time.Hour, want: "activities_1h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.step.String(), func(t *testing.T) {
+			source := PickSourceForStep(raw, rollups, tt.step, 0, nil, time.Now())
+			assert.Equal(t, tt.want, source.Table)
+			assert.Equal(t, tt.step, source.Step)
+		})
+	}
+
+	// the raw table does not cover a period older than its retention, the step is rounded up to the rollup buckets
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	from := now.AddDate(0, 0, -3).Unix()
+	source := PickSourceForStep(raw, rollups, 90*time.Second, from, map[string]uint{"activities": 2}, now)
+	assert.Equal(t, "activities_1m", source.Table)
+	assert.Equal(t, 2*time.Minute, source.Step)
+
+	source = PickSourceForStep(raw, rollups, 30*time.Minute, from, map[string]uint{"activities": 2, "activities_10m": 2}, now)
+	assert.Equal(t, "activities_1m", source.Table)
+	assert.Equal(t, 30*time.Minute, source.Step)
+
+	source = PickSourceForStep(raw, rollups, 10*time.Second, now.Add(-time.Hour).Unix(), map[string]uint{"activities": 2}, now)
+	assert.Equal(t, "activities", source.Table)
+	assert.Equal(t, 10*time.Second, source.Step)
+}
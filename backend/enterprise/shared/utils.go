@@ -60,7 +60,7 @@ func MakeMetrics(mm, t M, durationSec int64) map[string]*proto.MetricValues {
 	for k := range commonColumnNames {
 		cnt := interfaceToFloat32(mm["m_"+k+"_cnt"])
 		sum := interfaceToFloat32(mm["m_"+k+"_sum"])
-		totalSum := interfaceToFloat32(mm["m_"+k+"sum"])
+		totalSum := interfaceToFloat32(t["m_"+k+"_sum"])
 		mv := proto.MetricValues{
 			Cnt: cnt,
 			Sum: sum,
@@ -83,7 +83,7 @@ func MakeMetrics(mm, t M, durationSec int64) map[string]*proto.MetricValues {
 	for k := range sumColumnNames {
 		cnt := interfaceToFloat32(mm["m_"+k+"_cnt"])
 		sum := interfaceToFloat32(mm["m_"+k+"_sum"])
-		totalSum := interfaceToFloat32(t["m_"+k+"sum"])
+		totalSum := interfaceToFloat32(t["m_"+k+"_sum"])
 		mv := proto.MetricValues{
 			Cnt: cnt,
 			Sum: sum,
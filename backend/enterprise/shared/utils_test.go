@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMakeMetrics(t *testing.T) {
+	metrics := M{
+		"num_queries":         float64(10),
+		"m_query_time_cnt":    float64(10),
+		"m_query_time_sum":    float64(5),
+		"m_cpu_user_time_sum": float64(2),
+		"m_wal_bytes_sum":     float64(8192),
+		"m_plan_time_sum":     float64(1),
+	}
+	totals := M{
+		"num_queries":         float64(40),
+		"m_query_time_sum":    float64(20),
+		"m_cpu_user_time_sum": float64(8),
+		"m_wal_bytes_sum":     float64(8192),
+	}
+
+	got := MakeMetrics(metrics, totals, 10)
+
+	assert.Equal(t, float32(0.5), got["query_time"].Avg)
+	assert.Equal(t, float32(0.25), got["query_time"].PercentOfTotal)
+	assert.Equal(t, float32(2), got["cpu_user_time"].Sum)
+	assert.Equal(t, float32(0.2), got["cpu_user_time"].Rate)
+	assert.Equal(t, float32(0.25), got["cpu_user_time"].PercentOfTotal)
+	assert.Equal(t, float32(1), got["wal_bytes"].PercentOfTotal)
+	assert.Equal(t, float32(0), got["plan_time"].PercentOfTotal, "no totals")
+	for _, name := range []string{"cpu_sys_time", "plans_calls", "wal_records", "wal_fpi", "jit_functions", "jit_generation_time", "jit_inlining_time", "jit_optimization_time", "jit_emission_time"} {
+		assert.Contains(t, got, name)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+	"strconv"
+)
+
+// optionalUint is the value of a uint flag which can be left unset, unlike a flag with a default value
+type optionalUint struct {
+	value uint
+	set   bool
+}
+
+func optionalUintFlag(flag *kingpin.FlagClause) *optionalUint {
+	value := &optionalUint{}
+	flag.SetValue(value)
+	return value
+}
+
+func (o *optionalUint) Set(s string) error {
+	value, err := strconv.ParseUint(s, 10, 0)
+	if err != nil {
+		return err
+	}
+
+	o.value = uint(value)
+	o.set = true
+	return nil
+}
+
+func (o *optionalUint) String() string {
+	if !o.set {
+		return ""
+	}
+	return strconv.FormatUint(uint64(o.value), 10)
+}
+
+// orDefault returns the value of the flag, or the default value when the flag is unset
+func (o *optionalUint) orDefault(defaultValue uint) uint {
+	if !o.set {
+		return defaultValue
+	}
+	return o.value
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"github.com/golang-migrate/migrate"
+	"github.com/golang-migrate/migrate/source"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+var (
+	migrateCommand       = kingpin.Command("migrate", "manage the schema of the storage")
+	migrateStatusCommand = migrateCommand.Command("status", "print the current and the latest schema version")
+	migrateUpCommand     = migrateCommand.Command("up", "apply every pending migration")
+	migrateDownCommand   = migrateCommand.Command("down", "roll back the latest migrations")
+	migrateDownSteps     = migrateDownCommand.Arg("steps", "number of migrations to roll back").Default("1").Int()
+	migrateForceCommand  = migrateCommand.Command("force", "set the ClickHouse schema version without running any migration, used to fix a dirty schema")
+	migrateForceVersion  = migrateForceCommand.Arg("version", "schema version to set").Required().Int()
+)
+
+func isMigrateCommand(command string) bool {
+	return strings.HasPrefix(command, migrateCommand.FullCommand()+" ")
+}
+
+func newMigrate(dsn, migrationFolder string) (*migrate.Migrate, error) {
+	// migrations creating rollups contain several statements, each one ending with a semicolon
+	return migrate.New(fmt.Sprintf("file://%v", migrationFolder), dsn+"?x-multi-statement=true")
+}
+
+// latestMigration returns the version of the last migration shipped with the binary
+func latestMigration(migrationFolder string) (uint, error) {
+	src, err := source.Open(fmt.Sprintf("file://%v", migrationFolder))
+	if err != nil {
+		return 0, fmt.Errorf("could not open migrations: %v", err)
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return 0, fmt.Errorf("could not read first migration: %v", err)
+	}
+	for {
+		next, err := src.Next(version)
+		if os.IsNotExist(err) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("could not read migration after %d: %v", version, err)
+		}
+		version = next
+	}
+}
+
+// schemaVersion returns the version of the schema, 0 if no migration was applied
+func schemaVersion(m *migrate.Migrate) (uint, bool, error) {
+	version, dirty, err := m.Version()
+	if err == migrate.ErrNilVersion {
+		return 0, false, nil
+	}
+
+	return version, dirty, err
+}
+
+func runMigrations(dsn string, log *logrus.Entry, migrationFolder string) error {
+	log.Infof("dsn: %v", dsn)
+	m, err := newMigrate(dsn, migrationFolder)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := checkSchemaVersion(m, migrationFolder); err != nil {
+		return err
+	}
+
+	// run up to the latest migration
+	err = m.Up()
+	if err == migrate.ErrNoChange {
+		return nil
+	}
+	return err
+}
+
+// checkMigrations only checks the schema version, used when the migrations are applied with backend migrate
+func checkMigrations(dsn string, migrationFolder string) error {
+	m, err := newMigrate(dsn, migrationFolder)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	return checkSchemaVersion(m, migrationFolder)
+}
+
+// checkSchemaVersion refuses a dirty schema or a schema created by a newer binary,
+// the queries of this binary may not work with it.
+func checkSchemaVersion(m *migrate.Migrate, migrationFolder string) error {
+	version, dirty, err := schemaVersion(m)
+	if err != nil {
+		return fmt.Errorf("could not get schema version: %v", err)
+	}
+	if dirty {
+		return fmt.Errorf("the schema is dirty at version %d, fix it and run: backend migrate force <version>", version)
+	}
+
+	latest, err := latestMigration(migrationFolder)
+	if err != nil {
+		return err
+	}
+	if version > latest {
+		return fmt.Errorf(
+			"schema version %d is newer than the latest migration %d of this binary, upgrade the backend or run: backend migrate down",
+			version,
+			latest,
+		)
+	}
+
+	return nil
+}
+
+func runMigrate(command string, log *logrus.Entry) {
+	if *storage != storageClickHouse {
+		runSQLMigrate(command, log)
+		return
+	}
+
+	// connect creates the database if it does not exist yet
+	db, err := connect(clickhouseDSN(), log)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	_ = db.Close()
+
+	m, err := newMigrate(clickhouseDSN(), *migrationsFolder)
+	if err != nil {
+		log.Fatalf("could not open migrations: %v", err)
+	}
+	defer m.Close()
+
+	switch command {
+	case migrateStatusCommand.FullCommand():
+		version, dirty, err := schemaVersion(m)
+		if err != nil {
+			log.Fatalf("could not get schema version: %v", err)
+		}
+		latest, err := latestMigration(*migrationsFolder)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Printf("version: %d\ndirty: %v\nlatest: %d\n", version, dirty, latest)
+		return
+	case migrateUpCommand.FullCommand():
+		err = m.Up()
+	case migrateDownCommand.FullCommand():
+		if *migrateDownSteps < 1 {
+			log.Fatalf("steps must be at least 1")
+		}
+		err = m.Steps(-*migrateDownSteps)
+	case migrateForceCommand.FullCommand():
+		err = m.Force(*migrateForceVersion)
+	}
+
+	if err == migrate.ErrNoChange {
+		log.Infof("No change")
+		return
+	}
+	if err != nil {
+		log.Fatalf("could not %v: %v", command, err)
+	}
+
+	version, dirty, err := schemaVersion(m)
+	if err != nil {
+		log.Fatalf("could not get schema version: %v", err)
+	}
+	log.Infof("Schema at version %d, dirty: %v", version, dirty)
+}
+
+// runSQLMigrate manages the schema of the SQLite or PostgreSQL storage of the core mode,
+// its migrations are recorded by file name thus the schema is never dirty
+func runSQLMigrate(command string, log *logrus.Entry) {
+	driver, dsn := storageSQLite, *sqlitePath
+	if *storage == storagePostgres {
+		driver, dsn = storagePostgres, *postgresDSN
+	}
+	db := NewSQLDB(driver, dsn, maxIdleConns, maxOpenConns, log, *migrationsFolder, true)
+	defer db.Close()
+	migrationFolder := filepath.Join(*migrationsFolder, driver)
+
+	var err error
+	switch command {
+	case migrateStatusCommand.FullCommand():
+		version, err := sqlSchemaVersion(db)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		files, err := sqlMigrationFiles(migrationFolder)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Printf("version: %v\nlatest: %v\n", version, filepath.Base(files[len(files)-1]))
+		return
+	case migrateUpCommand.FullCommand():
+		err = runSQLMigrations(db, migrationFolder)
+	case migrateDownCommand.FullCommand():
+		if *migrateDownSteps < 1 {
+			log.Fatalf("steps must be at least 1")
+		}
+		err = rollbackSQLMigrations(db, migrationFolder, *migrateDownSteps)
+	case migrateForceCommand.FullCommand():
+		log.Fatalf("force is only available with %v storage, the %v schema is never dirty", storageClickHouse, *storage)
+	}
+
+	if err == migrate.ErrNoChange {
+		log.Infof("No change")
+		return
+	}
+	if err != nil {
+		log.Fatalf("could not %v: %v", command, err)
+	}
+
+	version, err := sqlSchemaVersion(db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	log.Infof("Schema at version %v", version)
+}
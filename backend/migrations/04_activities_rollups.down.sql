@@ -0,0 +1,6 @@
+DROP VIEW activities_1h_mv;
+DROP TABLE activities_1h;
+DROP VIEW activities_10m_mv;
+DROP TABLE activities_10m;
+DROP VIEW activities_1m_mv;
+DROP TABLE activities_1m;
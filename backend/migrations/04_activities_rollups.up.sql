@@ -0,0 +1,194 @@
+CREATE TABLE activities_1m
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 1 minute bucket',
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_1m_mv TO activities_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_1m
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+CREATE TABLE activities_10m
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 10 minutes bucket',
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_10m_mv TO activities_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_10m
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+CREATE TABLE activities_1h
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 1 hour bucket',
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_1h_mv TO activities_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_1h
+SELECT toStartOfHour(period_start) AS bucket_start,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
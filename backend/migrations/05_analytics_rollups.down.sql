@@ -0,0 +1,6 @@
+DROP VIEW analytics_1h_mv;
+DROP TABLE analytics_1h;
+DROP VIEW analytics_10m_mv;
+DROP TABLE analytics_10m;
+DROP VIEW analytics_1m_mv;
+DROP TABLE analytics_1m;
@@ -0,0 +1,614 @@
+ALTER TABLE plans ADD COLUMN `organization` LowCardinality(String) DEFAULT 'default' FIRST;
+ALTER TABLE analytics ADD COLUMN `organization` LowCardinality(String) DEFAULT 'default' FIRST;
+ALTER TABLE activities ADD COLUMN `organization` LowCardinality(String) DEFAULT 'default' FIRST;
+
+DROP VIEW activities_1h_mv;
+DROP TABLE activities_1h;
+DROP VIEW activities_10m_mv;
+DROP TABLE activities_10m;
+DROP VIEW activities_1m_mv;
+DROP TABLE activities_1m;
+
+DROP VIEW analytics_1h_mv;
+DROP TABLE analytics_1h;
+DROP VIEW analytics_10m_mv;
+DROP TABLE analytics_10m;
+DROP VIEW analytics_1m_mv;
+DROP TABLE analytics_1m;
+
+CREATE TABLE activities_1m
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 1 minute bucket',
+    `organization`     LowCardinality(String),
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_1m_mv TO activities_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_1m
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+CREATE TABLE activities_10m
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 10 minutes bucket',
+    `organization`     LowCardinality(String),
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_10m_mv TO activities_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_10m
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+CREATE TABLE activities_1h
+(
+    `bucket_start`     DateTime COMMENT 'Start of the 1 hour bucket',
+    `organization`     LowCardinality(String),
+    `cluster_name`     LowCardinality(String),
+    `instance_name`    LowCardinality(String),
+    `datname`          LowCardinality(String),
+    `usename`          LowCardinality(String),
+    `application_name` LowCardinality(String),
+    `client_hostname`  LowCardinality(String),
+    `backend_type`     LowCardinality(String),
+    `state`            LowCardinality(String),
+    `wait_event_type`  LowCardinality(String),
+    `wait_event`       LowCardinality(String),
+    `wait_event_count` SimpleAggregateFunction(sum, UInt64) COMMENT 'Number of samples in the bucket',
+    `cpu_cores`        SimpleAggregateFunction(anyLast, Float32)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           bucket_start,
+           wait_event,
+           instance_name,
+           datname,
+           usename,
+           application_name,
+           client_hostname,
+           backend_type,
+           state,
+           wait_event_type
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW activities_1h_mv TO activities_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+INSERT INTO activities_1h
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+CREATE TABLE analytics_1m
+(
+    `bucket_start`              DateTime COMMENT 'Start of the 1 minute bucket',
+    `organization`              LowCardinality(String),
+    `cluster_name`              LowCardinality(String),
+    `instance_name`             LowCardinality(String),
+    `queryid`                   LowCardinality(String),
+    `fingerprint`               LowCardinality(String),
+    `database`                  LowCardinality(String),
+    `schema`                    LowCardinality(String),
+    `username`                  LowCardinality(String),
+    `client_host`               LowCardinality(String),
+    `num_queries`               SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_errors`   SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_warnings` SimpleAggregateFunction(sum, Float64),
+    `m_query_time_cnt`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_sum`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_min`          SimpleAggregateFunction(min, Float32),
+    `m_query_time_max`          SimpleAggregateFunction(max, Float32),
+    `m_query_time_p99`          AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_rows_sent_cnt`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_sum`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_min`           SimpleAggregateFunction(min, Float32),
+    `m_rows_sent_max`           SimpleAggregateFunction(max, Float32),
+    `m_rows_sent_p99`           AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_shared_blks_hit_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_read_sum`    SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_dirtied_sum` SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_written_sum` SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_hit_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_read_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_dirtied_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_written_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_read_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_written_sum`   SimpleAggregateFunction(sum, Float64),
+    `m_blk_read_time_sum`       SimpleAggregateFunction(sum, Float64),
+    `m_blk_write_time_sum`      SimpleAggregateFunction(sum, Float64)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           fingerprint,
+           bucket_start,
+           instance_name,
+           queryid,
+           database,
+           schema,
+           username,
+           client_host
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW analytics_1m_mv TO analytics_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+INSERT INTO analytics_1m
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+CREATE TABLE analytics_10m
+(
+    `bucket_start`              DateTime COMMENT 'Start of the 10 minutes bucket',
+    `organization`              LowCardinality(String),
+    `cluster_name`              LowCardinality(String),
+    `instance_name`             LowCardinality(String),
+    `queryid`                   LowCardinality(String),
+    `fingerprint`               LowCardinality(String),
+    `database`                  LowCardinality(String),
+    `schema`                    LowCardinality(String),
+    `username`                  LowCardinality(String),
+    `client_host`               LowCardinality(String),
+    `num_queries`               SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_errors`   SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_warnings` SimpleAggregateFunction(sum, Float64),
+    `m_query_time_cnt`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_sum`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_min`          SimpleAggregateFunction(min, Float32),
+    `m_query_time_max`          SimpleAggregateFunction(max, Float32),
+    `m_query_time_p99`          AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_rows_sent_cnt`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_sum`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_min`           SimpleAggregateFunction(min, Float32),
+    `m_rows_sent_max`           SimpleAggregateFunction(max, Float32),
+    `m_rows_sent_p99`           AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_shared_blks_hit_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_read_sum`    SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_dirtied_sum` SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_written_sum` SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_hit_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_read_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_dirtied_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_written_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_read_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_written_sum`   SimpleAggregateFunction(sum, Float64),
+    `m_blk_read_time_sum`       SimpleAggregateFunction(sum, Float64),
+    `m_blk_write_time_sum`      SimpleAggregateFunction(sum, Float64)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           fingerprint,
+           bucket_start,
+           instance_name,
+           queryid,
+           database,
+           schema,
+           username,
+           client_host
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW analytics_10m_mv TO analytics_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+INSERT INTO analytics_10m
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+CREATE TABLE analytics_1h
+(
+    `bucket_start`              DateTime COMMENT 'Start of the 1 hour bucket',
+    `organization`              LowCardinality(String),
+    `cluster_name`              LowCardinality(String),
+    `instance_name`             LowCardinality(String),
+    `queryid`                   LowCardinality(String),
+    `fingerprint`               LowCardinality(String),
+    `database`                  LowCardinality(String),
+    `schema`                    LowCardinality(String),
+    `username`                  LowCardinality(String),
+    `client_host`               LowCardinality(String),
+    `num_queries`               SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_errors`   SimpleAggregateFunction(sum, Float64),
+    `num_queries_with_warnings` SimpleAggregateFunction(sum, Float64),
+    `m_query_time_cnt`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_sum`          SimpleAggregateFunction(sum, Float64),
+    `m_query_time_min`          SimpleAggregateFunction(min, Float32),
+    `m_query_time_max`          SimpleAggregateFunction(max, Float32),
+    `m_query_time_p99`          AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_rows_sent_cnt`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_sum`           SimpleAggregateFunction(sum, Float64),
+    `m_rows_sent_min`           SimpleAggregateFunction(min, Float32),
+    `m_rows_sent_max`           SimpleAggregateFunction(max, Float32),
+    `m_rows_sent_p99`           AggregateFunction(avg, Float32) COMMENT 'Use avgMerge to read it',
+    `m_shared_blks_hit_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_read_sum`    SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_dirtied_sum` SimpleAggregateFunction(sum, Float64),
+    `m_shared_blks_written_sum` SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_hit_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_read_sum`     SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_dirtied_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_local_blks_written_sum`  SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_read_sum`      SimpleAggregateFunction(sum, Float64),
+    `m_temp_blks_written_sum`   SimpleAggregateFunction(sum, Float64),
+    `m_blk_read_time_sum`       SimpleAggregateFunction(sum, Float64),
+    `m_blk_write_time_sum`      SimpleAggregateFunction(sum, Float64)
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           fingerprint,
+           bucket_start,
+           instance_name,
+           queryid,
+           database,
+           schema,
+           username,
+           client_host
+              ) SETTINGS index_granularity = 8192;
+
+CREATE MATERIALIZED VIEW analytics_1h_mv TO analytics_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+INSERT INTO analytics_1h
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
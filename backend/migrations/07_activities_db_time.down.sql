@@ -0,0 +1,60 @@
+DROP VIEW activities_1m_mv;
+CREATE MATERIALIZED VIEW activities_1m_mv TO activities_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+DROP VIEW activities_10m_mv;
+CREATE MATERIALIZED VIEW activities_10m_mv TO activities_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+DROP VIEW activities_1h_mv;
+CREATE MATERIALIZED VIEW activities_1h_mv TO activities_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       datname,
+       usename,
+       application_name,
+       client_hostname,
+       backend_type,
+       state,
+       wait_event_type,
+       wait_event,
+       count() AS wait_event_count,
+       anyLast(cpu_cores) AS cpu_cores
+FROM activities
+GROUP BY bucket_start, organization, cluster_name, instance_name, datname, usename, application_name, client_hostname, backend_type, state, wait_event_type, wait_event;
+
+ALTER TABLE activities_1m DROP COLUMN `db_time_secs`;
+ALTER TABLE activities_10m DROP COLUMN `db_time_secs`;
+ALTER TABLE activities_1h DROP COLUMN `db_time_secs`;
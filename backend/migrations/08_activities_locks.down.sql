@@ -0,0 +1,3 @@
+ALTER TABLE activities DROP COLUMN `lock_mode`;
+ALTER TABLE activities DROP COLUMN `locked_relation`;
+ALTER TABLE activities DROP COLUMN `blocking_pids`;
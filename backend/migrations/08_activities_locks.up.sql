@@ -0,0 +1,3 @@
+ALTER TABLE activities ADD COLUMN `blocking_pids` Array(UInt32) COMMENT 'Pids of the sessions blocking the backend (pg_blocking_pids)' AFTER pid;
+ALTER TABLE activities ADD COLUMN `locked_relation` String COMMENT 'Relation of the lock the backend is waiting for' AFTER blocking_pids;
+ALTER TABLE activities ADD COLUMN `lock_mode` LowCardinality(String) COMMENT 'Mode of the lock the backend is waiting for' AFTER locked_relation;
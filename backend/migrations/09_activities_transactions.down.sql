@@ -0,0 +1,3 @@
+ALTER TABLE activities DROP COLUMN `state_change`;
+ALTER TABLE activities DROP COLUMN `backend_xmin`;
+ALTER TABLE activities DROP COLUMN `xact_start`;
@@ -0,0 +1,3 @@
+ALTER TABLE activities ADD COLUMN `xact_start` UInt32 COMMENT 'Time when the transaction of the session started, 0 outside of a transaction' AFTER query_start;
+ALTER TABLE activities ADD COLUMN `backend_xmin` UInt32 COMMENT 'Xmin horizon held by the session' AFTER xact_start;
+ALTER TABLE activities ADD COLUMN `state_change` UInt32 COMMENT 'Time when the state of the session last changed' AFTER backend_xmin;
@@ -0,0 +1,22 @@
+CREATE TABLE anomalies
+(
+    `organization`    LowCardinality(String),
+    `cluster_name`    LowCardinality(String),
+    `kind`            LowCardinality(String) COMMENT 'aas for the load of the cluster, query_load for the load of a fingerprint',
+    `fingerprint`     String COMMENT 'Fingerprint of the query_load anomalies, empty for the aas anomalies',
+    `window_start`    DateTime COMMENT 'Start of the first anomalous bucket',
+    `window_end`      DateTime COMMENT 'End of the last anomalous bucket',
+    `value`           Float64 COMMENT 'Highest average active sessions of the window',
+    `baseline_median` Float64 COMMENT 'Median of the hour of week baseline at the highest bucket',
+    `baseline_mad`    Float64 COMMENT 'Median absolute deviation of the baseline at the highest bucket',
+    `score`           Float64 COMMENT 'Highest robust z-score of the window',
+    `detected_at`     DateTime
+) ENGINE = ReplacingMergeTree(detected_at) PARTITION BY toYYYYMMDD(window_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           kind,
+           fingerprint,
+           window_start
+              ) SETTINGS index_granularity = 8192;
@@ -0,0 +1,185 @@
+DROP VIEW analytics_1m_mv;
+CREATE MATERIALIZED VIEW analytics_1m_mv TO analytics_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+DROP VIEW analytics_10m_mv;
+CREATE MATERIALIZED VIEW analytics_10m_mv TO analytics_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+DROP VIEW analytics_1h_mv;
+CREATE MATERIALIZED VIEW analytics_1h_mv TO analytics_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+ALTER TABLE analytics_1m DROP COLUMN `m_cpu_user_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_plans_calls_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_plan_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_wal_records_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_wal_fpi_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_wal_bytes_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_jit_functions_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_jit_generation_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_jit_optimization_time_sum`;
+ALTER TABLE analytics_1m DROP COLUMN `m_jit_emission_time_sum`;
+
+ALTER TABLE analytics_10m DROP COLUMN `m_cpu_user_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_plans_calls_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_plan_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_wal_records_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_wal_fpi_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_wal_bytes_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_jit_functions_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_jit_generation_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_jit_optimization_time_sum`;
+ALTER TABLE analytics_10m DROP COLUMN `m_jit_emission_time_sum`;
+
+ALTER TABLE analytics_1h DROP COLUMN `m_cpu_user_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_plans_calls_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_plan_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_wal_records_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_wal_fpi_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_wal_bytes_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_jit_functions_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_jit_generation_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_jit_optimization_time_sum`;
+ALTER TABLE analytics_1h DROP COLUMN `m_jit_emission_time_sum`;
+
+ALTER TABLE analytics DROP COLUMN `m_cpu_user_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_cpu_user_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_cpu_sys_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_cpu_sys_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_plans_calls_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_plans_calls_sum`;
+ALTER TABLE analytics DROP COLUMN `m_plan_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_plan_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_wal_records_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_wal_records_sum`;
+ALTER TABLE analytics DROP COLUMN `m_wal_fpi_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_wal_fpi_sum`;
+ALTER TABLE analytics DROP COLUMN `m_wal_bytes_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_wal_bytes_sum`;
+ALTER TABLE analytics DROP COLUMN `m_jit_functions_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_jit_functions_sum`;
+ALTER TABLE analytics DROP COLUMN `m_jit_generation_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_jit_generation_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_jit_inlining_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_jit_inlining_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_jit_optimization_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_jit_optimization_time_sum`;
+ALTER TABLE analytics DROP COLUMN `m_jit_emission_time_cnt`;
+ALTER TABLE analytics DROP COLUMN `m_jit_emission_time_sum`;
+ALTER TABLE analytics DROP COLUMN `top_queryid`;
+ALTER TABLE analytics DROP COLUMN `cmd_type`;
@@ -0,0 +1,221 @@
+ALTER TABLE analytics ADD COLUMN `cmd_type` LowCardinality(String) COMMENT 'Type of the statement: SELECT, INSERT, UPDATE, DELETE, MERGE, UTILITY or UNKNOWN' AFTER `is_truncated`;
+ALTER TABLE analytics ADD COLUMN `top_queryid` LowCardinality(String) COMMENT 'queryid of the top level statement calling the statement, empty for the top level statements' AFTER `cmd_type`;
+ALTER TABLE analytics ADD COLUMN `m_cpu_user_time_cnt` Float32 AFTER `m_blk_write_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_cpu_user_time_sum` Float32 COMMENT 'Total user CPU time of the statement, in seconds (requires pg_stat_kcache)' AFTER `m_cpu_user_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_cpu_sys_time_cnt` Float32 AFTER `m_cpu_user_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_cpu_sys_time_sum` Float32 COMMENT 'Total system CPU time of the statement, in seconds (requires pg_stat_kcache)' AFTER `m_cpu_sys_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_plans_calls_cnt` Float32 AFTER `m_cpu_sys_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_plans_calls_sum` Float32 COMMENT 'Number of times the statement was planned (if pg_stat_statements.track_planning is enabled, otherwise zero)' AFTER `m_plans_calls_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_plan_time_cnt` Float32 AFTER `m_plans_calls_sum`;
+ALTER TABLE analytics ADD COLUMN `m_plan_time_sum` Float32 COMMENT 'Total time spent planning the statement, in milliseconds (if pg_stat_statements.track_planning is enabled, otherwise zero)' AFTER `m_plan_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_wal_records_cnt` Float32 AFTER `m_plan_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_wal_records_sum` Float32 COMMENT 'Total number of WAL records generated by the statement' AFTER `m_wal_records_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_wal_fpi_cnt` Float32 AFTER `m_wal_records_sum`;
+ALTER TABLE analytics ADD COLUMN `m_wal_fpi_sum` Float32 COMMENT 'Total number of WAL full page images generated by the statement' AFTER `m_wal_fpi_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_wal_bytes_cnt` Float32 AFTER `m_wal_fpi_sum`;
+ALTER TABLE analytics ADD COLUMN `m_wal_bytes_sum` Float32 COMMENT 'Total amount of WAL generated by the statement, in bytes' AFTER `m_wal_bytes_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_jit_functions_cnt` Float32 AFTER `m_wal_bytes_sum`;
+ALTER TABLE analytics ADD COLUMN `m_jit_functions_sum` Float32 COMMENT 'Total number of functions JIT-compiled by the statement' AFTER `m_jit_functions_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_jit_generation_time_cnt` Float32 AFTER `m_jit_functions_sum`;
+ALTER TABLE analytics ADD COLUMN `m_jit_generation_time_sum` Float32 COMMENT 'Total time spent by the statement on generating JIT code, in milliseconds' AFTER `m_jit_generation_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_jit_inlining_time_cnt` Float32 AFTER `m_jit_generation_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_jit_inlining_time_sum` Float32 COMMENT 'Total time spent by the statement on inlining functions, in milliseconds' AFTER `m_jit_inlining_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_jit_optimization_time_cnt` Float32 AFTER `m_jit_inlining_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_jit_optimization_time_sum` Float32 COMMENT 'Total time spent by the statement on optimizing, in milliseconds' AFTER `m_jit_optimization_time_cnt`;
+ALTER TABLE analytics ADD COLUMN `m_jit_emission_time_cnt` Float32 AFTER `m_jit_optimization_time_sum`;
+ALTER TABLE analytics ADD COLUMN `m_jit_emission_time_sum` Float32 COMMENT 'Total time spent by the statement on emitting code, in milliseconds' AFTER `m_jit_emission_time_cnt`;
+
+ALTER TABLE analytics_1m ADD COLUMN `m_cpu_user_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_blk_write_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_cpu_sys_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_user_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_plans_calls_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_plan_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plans_calls_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_wal_records_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plan_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_wal_fpi_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_records_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_wal_bytes_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_fpi_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_jit_functions_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_bytes_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_jit_generation_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_functions_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_jit_inlining_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_generation_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_jit_optimization_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_1m ADD COLUMN `m_jit_emission_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_optimization_time_sum`;
+
+ALTER TABLE analytics_10m ADD COLUMN `m_cpu_user_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_blk_write_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_cpu_sys_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_user_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_plans_calls_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_plan_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plans_calls_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_wal_records_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plan_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_wal_fpi_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_records_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_wal_bytes_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_fpi_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_jit_functions_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_bytes_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_jit_generation_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_functions_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_jit_inlining_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_generation_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_jit_optimization_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_10m ADD COLUMN `m_jit_emission_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_optimization_time_sum`;
+
+ALTER TABLE analytics_1h ADD COLUMN `m_cpu_user_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_blk_write_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_cpu_sys_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_user_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_plans_calls_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_cpu_sys_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_plan_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plans_calls_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_wal_records_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_plan_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_wal_fpi_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_records_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_wal_bytes_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_fpi_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_jit_functions_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_wal_bytes_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_jit_generation_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_functions_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_jit_inlining_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_generation_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_jit_optimization_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_inlining_time_sum`;
+ALTER TABLE analytics_1h ADD COLUMN `m_jit_emission_time_sum` SimpleAggregateFunction(sum, Float64) AFTER `m_jit_optimization_time_sum`;
+
+DROP VIEW analytics_1m_mv;
+CREATE MATERIALIZED VIEW analytics_1m_mv TO analytics_1m AS
+SELECT toStartOfMinute(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum,
+       sum(m_cpu_user_time_sum) AS m_cpu_user_time_sum,
+       sum(m_cpu_sys_time_sum) AS m_cpu_sys_time_sum,
+       sum(m_plans_calls_sum) AS m_plans_calls_sum,
+       sum(m_plan_time_sum) AS m_plan_time_sum,
+       sum(m_wal_records_sum) AS m_wal_records_sum,
+       sum(m_wal_fpi_sum) AS m_wal_fpi_sum,
+       sum(m_wal_bytes_sum) AS m_wal_bytes_sum,
+       sum(m_jit_functions_sum) AS m_jit_functions_sum,
+       sum(m_jit_generation_time_sum) AS m_jit_generation_time_sum,
+       sum(m_jit_inlining_time_sum) AS m_jit_inlining_time_sum,
+       sum(m_jit_optimization_time_sum) AS m_jit_optimization_time_sum,
+       sum(m_jit_emission_time_sum) AS m_jit_emission_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+DROP VIEW analytics_10m_mv;
+CREATE MATERIALIZED VIEW analytics_10m_mv TO analytics_10m AS
+SELECT toStartOfTenMinutes(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum,
+       sum(m_cpu_user_time_sum) AS m_cpu_user_time_sum,
+       sum(m_cpu_sys_time_sum) AS m_cpu_sys_time_sum,
+       sum(m_plans_calls_sum) AS m_plans_calls_sum,
+       sum(m_plan_time_sum) AS m_plan_time_sum,
+       sum(m_wal_records_sum) AS m_wal_records_sum,
+       sum(m_wal_fpi_sum) AS m_wal_fpi_sum,
+       sum(m_wal_bytes_sum) AS m_wal_bytes_sum,
+       sum(m_jit_functions_sum) AS m_jit_functions_sum,
+       sum(m_jit_generation_time_sum) AS m_jit_generation_time_sum,
+       sum(m_jit_inlining_time_sum) AS m_jit_inlining_time_sum,
+       sum(m_jit_optimization_time_sum) AS m_jit_optimization_time_sum,
+       sum(m_jit_emission_time_sum) AS m_jit_emission_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
+
+DROP VIEW analytics_1h_mv;
+CREATE MATERIALIZED VIEW analytics_1h_mv TO analytics_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       instance_name,
+       queryid,
+       fingerprint,
+       database,
+       schema,
+       username,
+       client_host,
+       sum(num_queries) AS num_queries,
+       sum(num_queries_with_errors) AS num_queries_with_errors,
+       sum(num_queries_with_warnings) AS num_queries_with_warnings,
+       sum(m_query_time_cnt) AS m_query_time_cnt,
+       sum(m_query_time_sum) AS m_query_time_sum,
+       min(m_query_time_min) AS m_query_time_min,
+       max(m_query_time_max) AS m_query_time_max,
+       avgState(m_query_time_p99) AS m_query_time_p99,
+       sum(m_rows_sent_cnt) AS m_rows_sent_cnt,
+       sum(m_rows_sent_sum) AS m_rows_sent_sum,
+       min(m_rows_sent_min) AS m_rows_sent_min,
+       max(m_rows_sent_max) AS m_rows_sent_max,
+       avgState(m_rows_sent_p99) AS m_rows_sent_p99,
+       sum(m_shared_blks_hit_sum) AS m_shared_blks_hit_sum,
+       sum(m_shared_blks_read_sum) AS m_shared_blks_read_sum,
+       sum(m_shared_blks_dirtied_sum) AS m_shared_blks_dirtied_sum,
+       sum(m_shared_blks_written_sum) AS m_shared_blks_written_sum,
+       sum(m_local_blks_hit_sum) AS m_local_blks_hit_sum,
+       sum(m_local_blks_read_sum) AS m_local_blks_read_sum,
+       sum(m_local_blks_dirtied_sum) AS m_local_blks_dirtied_sum,
+       sum(m_local_blks_written_sum) AS m_local_blks_written_sum,
+       sum(m_temp_blks_read_sum) AS m_temp_blks_read_sum,
+       sum(m_temp_blks_written_sum) AS m_temp_blks_written_sum,
+       sum(m_blk_read_time_sum) AS m_blk_read_time_sum,
+       sum(m_blk_write_time_sum) AS m_blk_write_time_sum,
+       sum(m_cpu_user_time_sum) AS m_cpu_user_time_sum,
+       sum(m_cpu_sys_time_sum) AS m_cpu_sys_time_sum,
+       sum(m_plans_calls_sum) AS m_plans_calls_sum,
+       sum(m_plan_time_sum) AS m_plan_time_sum,
+       sum(m_wal_records_sum) AS m_wal_records_sum,
+       sum(m_wal_fpi_sum) AS m_wal_fpi_sum,
+       sum(m_wal_bytes_sum) AS m_wal_bytes_sum,
+       sum(m_jit_functions_sum) AS m_jit_functions_sum,
+       sum(m_jit_generation_time_sum) AS m_jit_generation_time_sum,
+       sum(m_jit_inlining_time_sum) AS m_jit_inlining_time_sum,
+       sum(m_jit_optimization_time_sum) AS m_jit_optimization_time_sum,
+       sum(m_jit_emission_time_sum) AS m_jit_emission_time_sum
+FROM analytics
+GROUP BY bucket_start, organization, cluster_name, instance_name, queryid, fingerprint, database, schema, username, client_host;
@@ -0,0 +1,11 @@
+ALTER TABLE activities RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE activities_1m RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE activities_10m RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE activities_1h RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE analytics RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE analytics_1m RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE analytics_10m RESET SETTING non_replicated_deduplication_window;
+ALTER TABLE analytics_1h RESET SETTING non_replicated_deduplication_window;
+
+ALTER TABLE activities DROP COLUMN `batch_id`;
+ALTER TABLE analytics DROP COLUMN `batch_id`;
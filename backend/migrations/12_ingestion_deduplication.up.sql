@@ -0,0 +1,11 @@
+ALTER TABLE activities ADD COLUMN `batch_id` String COMMENT 'Identifier of the collector request, the same for its retries';
+ALTER TABLE analytics ADD COLUMN `batch_id` String COMMENT 'Identifier of the collector request, the same for its retries';
+
+ALTER TABLE activities MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE activities_1m MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE activities_10m MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE activities_1h MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE analytics MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE analytics_1m MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE analytics_10m MODIFY SETTING non_replicated_deduplication_window = 10000;
+ALTER TABLE analytics_1h MODIFY SETTING non_replicated_deduplication_window = 10000;
@@ -0,0 +1 @@
+ALTER TABLE activities ADD COLUMN `backend_start` UInt32 COMMENT 'Time when the backend of the session started, 0 when unknown' AFTER state_change;
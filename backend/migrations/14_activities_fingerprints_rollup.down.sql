@@ -0,0 +1,2 @@
+DROP VIEW activities_fingerprints_1h_mv;
+DROP TABLE activities_fingerprints_1h;
@@ -0,0 +1,35 @@
+CREATE TABLE activities_fingerprints_1h
+(
+    `bucket_start` DateTime COMMENT 'Start of the 1 hour bucket',
+    `organization` LowCardinality(String),
+    `cluster_name` LowCardinality(String),
+    `fingerprint`  String,
+    `db_time_secs` SimpleAggregateFunction(sum, UInt64) COMMENT 'Seconds of database time of the fingerprint in the bucket'
+) ENGINE = AggregatingMergeTree PARTITION BY toYYYYMMDD(bucket_start)
+      ORDER BY
+          (
+           organization,
+           cluster_name,
+           bucket_start,
+           fingerprint
+              ) SETTINGS index_granularity = 8192, non_replicated_deduplication_window = 10000;
+
+CREATE MATERIALIZED VIEW activities_fingerprints_1h_mv TO activities_fingerprints_1h AS
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       fingerprint,
+       sum(period_length) AS db_time_secs
+FROM activities
+WHERE fingerprint != ''
+GROUP BY bucket_start, organization, cluster_name, fingerprint;
+
+INSERT INTO activities_fingerprints_1h
+SELECT toStartOfHour(period_start) AS bucket_start,
+       organization,
+       cluster_name,
+       fingerprint,
+       sum(period_length) AS db_time_secs
+FROM activities
+WHERE fingerprint != ''
+GROUP BY bucket_start, organization, cluster_name, fingerprint;
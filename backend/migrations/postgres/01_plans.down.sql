@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS plans_cluster_period_start_idx;
+DROP TABLE IF EXISTS plans;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS plans_organization_cluster_period_start_idx;
+ALTER TABLE plans DROP COLUMN organization;
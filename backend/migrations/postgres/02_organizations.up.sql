@@ -0,0 +1,2 @@
+ALTER TABLE plans ADD COLUMN organization TEXT NOT NULL DEFAULT 'default';
+CREATE INDEX IF NOT EXISTS plans_organization_cluster_period_start_idx ON plans (organization, cluster, period_start);
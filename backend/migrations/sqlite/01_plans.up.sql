@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS plans
+(
+    id                TEXT PRIMARY KEY,
+    alias             TEXT,
+    query_fingerprint TEXT NOT NULL DEFAULT '',
+    queryid           TEXT,
+    query_sha         TEXT NOT NULL DEFAULT '',
+    plan              TEXT NOT NULL,
+    original_plan     TEXT NOT NULL,
+    query             TEXT NOT NULL,
+    database          TEXT NOT NULL DEFAULT '',
+    schema            TEXT NOT NULL DEFAULT '',
+    username          TEXT NOT NULL DEFAULT '',
+    cluster           TEXT NOT NULL,
+    period_start      DATETIME NOT NULL,
+    period_length     INTEGER NOT NULL DEFAULT 0,
+    optimization_id   TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS plans_cluster_period_start_idx ON plans (cluster, period_start);
@@ -10,6 +10,10 @@ import (
 	"postgres-explain/backend/cache"
 )
 
+// ClickHouseDriver is the name of the ClickHouse database/sql driver,
+// enterprise modules can only run on ClickHouse.
+const ClickHouseDriver = "clickhouse"
+
 type Module interface {
 	Register(log *logrus.Entry, db *sqlx.DB, credentialsProvider credentials.Credentials, params Params)
 	Init(initArgs InitArgs) error
@@ -17,6 +21,43 @@ type Module interface {
 
 type Params struct {
 	WaitEventsMapFilePath string `json:"waitEventsMapFilePath"`
+	// RetentionDays is the number of days of data kept for each table, 0 means forever
+	RetentionDays map[string]uint `json:"retentionDays"`
+	// ActivitiesQueueSize is the number of collector requests of activity samples waiting to be inserted, 0 uses the default size
+	ActivitiesQueueSize int `json:"activitiesQueueSize"`
+	// ActivitiesQueuePolicy is what happens to the requests of activity samples when the queue is full: block, drop-oldest or reject
+	ActivitiesQueuePolicy string `json:"activitiesQueuePolicy"`
+	// AnomaliesDetector runs the anomalies detector, it must run on a single replica
+	AnomaliesDetector bool `json:"anomaliesDetector"`
+	// Tables are the tables of every module which can be exported and imported
+	Tables []Table `json:"-"`
+}
+
+// TablesOwner is implemented by the modules storing data which can be exported and imported
+type TablesOwner interface {
+	Tables() []Table
+}
+
+// Waiter is implemented by the modules running background work, such as storing the queued samples,
+// Wait returns once the work is finished after the cancellation of InitArgs.Ctx
+type Waiter interface {
+	Wait()
+}
+
+// Table is exported by reading the rows with SelectSQL into NewRow and imported by writing them back with InsertSQL.
+// SelectSQL must filter by :organization, :cluster_name, :period_start_from and :period_start_to
+// and name its columns after the parameters of InsertSQL.
+type Table struct {
+	Name      string
+	SelectSQL string
+	InsertSQL string
+	NewRow    func() interface{}
+}
+
+// OrganizationRow is implemented by the rows of a Table, so that an import can assign them to an organization
+type OrganizationRow interface {
+	GetOrganization() string
+	SetOrganization(organization string)
 }
 
 type InitArgs struct {
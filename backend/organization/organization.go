@@ -0,0 +1,172 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// Default is the organization of the data stored before organizations were introduced,
+// and of every call when authentication is disabled.
+const Default = "default"
+
+const (
+	metadataKey              = "x-organization"
+	gatewaySecretMetadataKey = "x-gateway-secret"
+	authorizationMetadataKey = "authorization"
+)
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, organization string) context.Context {
+	return context.WithValue(ctx, contextKey{}, organization)
+}
+
+// FromContext returns the organization of the call, Default if it is missing
+func FromContext(ctx context.Context) string {
+	if organization, ok := ctx.Value(contextKey{}).(string); ok && organization != "" {
+		return organization
+	}
+
+	return Default
+}
+
+// Resolver derives the organization of the grpc calls.
+// Calls coming from the HTTP gateway carry the organization of the authenticated identity,
+// the others (collectors) are mapped to an organization by their token.
+type Resolver struct {
+	// gatewaySecret proves that the call comes from the gateway, thus its organization metadata can be trusted
+	gatewaySecret   string
+	collectorTokens map[string]string
+}
+
+// NewResolver creates a Resolver, if no collector tokens are given every collector belongs to Default.
+func NewResolver(collectorTokens map[string]string) (*Resolver, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("could not generate gateway secret: %v", err)
+	}
+
+	return &Resolver{gatewaySecret: hex.EncodeToString(secret), collectorTokens: collectorTokens}, nil
+}
+
+// ParseCollectorTokens parses a comma separated list of token=organization
+func ParseCollectorTokens(tokens string) (map[string]string, error) {
+	collectorTokens := make(map[string]string)
+	for _, pair := range strings.Split(tokens, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		token, organization, ok := strings.Cut(pair, "=")
+		if !ok || token == "" || organization == "" {
+			return nil, fmt.Errorf("collector token must be in the form token=organization")
+		}
+		collectorTokens[token] = organization
+	}
+
+	return collectorTokens, nil
+}
+
+// IncomingHeaderMatcher forwards the headers like runtime.DefaultHeaderMatcher, except the ones of the metadata set by
+// GatewayMetadata: a client could otherwise send its own organization. Use it with runtime.WithIncomingHeaderMatcher.
+func (r *Resolver) IncomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case strings.ToLower(runtime.MetadataHeaderPrefix) + metadataKey,
+		strings.ToLower(runtime.MetadataHeaderPrefix) + gatewaySecretMetadataKey:
+		return "", false
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// GatewayMetadata forwards the organization set by the authentication middleware, use it with runtime.WithMetadata
+func (r *Resolver) GatewayMetadata(ctx context.Context, req *http.Request) metadata.MD {
+	return metadata.Pairs(
+		metadataKey, FromContext(req.Context()),
+		gatewaySecretMetadataKey, r.gatewaySecret,
+	)
+}
+
+func (r *Resolver) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		organization, err := r.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(NewContext(ctx, organization), req)
+	}
+}
+
+func (r *Resolver) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		organization, err := r.resolve(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: NewContext(ss.Context(), organization)})
+	}
+}
+
+func (r *Resolver) resolve(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// a key with several values means that a client added its own values to the ones of the gateway
+	for _, key := range []string{metadataKey, gatewaySecretMetadataKey} {
+		if len(md.Get(key)) > 1 {
+			return "", status.Errorf(codes.Unauthenticated, "metadata %v must have a single value", key)
+		}
+	}
+
+	if secret := first(md, gatewaySecretMetadataKey); secret != "" {
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(r.gatewaySecret)) != 1 {
+			return "", status.Errorf(codes.Unauthenticated, "gateway secret is not valid")
+		}
+		if organization := first(md, metadataKey); organization != "" {
+			return organization, nil
+		}
+		return Default, nil
+	}
+
+	if len(r.collectorTokens) == 0 {
+		return Default, nil
+	}
+
+	token := strings.TrimPrefix(first(md, authorizationMetadataKey), "Bearer ")
+	organization, ok := r.collectorTokens[token]
+	if !ok || token == "" {
+		return "", status.Errorf(codes.Unauthenticated, "collector token is missing or not valid")
+	}
+
+	return organization, nil
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// serverStream overrides the context of the stream with the one containing the organization
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,113 @@
+package organization
+
+import (
+	"context"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"testing"
+)
+
+func TestParseCollectorTokens(t *testing.T) {
+	tokens, err := ParseCollectorTokens("token-a=team-a, token-b=team-b,")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"token-a": "team-a", "token-b": "team-b"}, tokens)
+
+	tokens, err = ParseCollectorTokens("")
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	_, err = ParseCollectorTokens("token-a")
+	assert.Error(t, err)
+}
+
+func TestResolver_resolve(t *testing.T) {
+	resolver, err := NewResolver(map[string]string{"token-a": "team-a"})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/", nil)
+	assert.NoError(t, err)
+	gatewayMD := resolver.GatewayMetadata(context.Background(), request.WithContext(NewContext(request.Context(), "team-b")))
+
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "gateway call",
+			md:   gatewayMD,
+			want: "team-b",
+		},
+		{
+			name:    "forged gateway call",
+			md:      metadata.Pairs(metadataKey, "team-b", gatewaySecretMetadataKey, "random"),
+			wantErr: true,
+		},
+		{
+			name: "collector call",
+			md:   metadata.Pairs(authorizationMetadataKey, "Bearer token-a"),
+			want: "team-a",
+		},
+		{
+			name:    "gateway call with a forged organization",
+			md:      metadata.Join(metadata.Pairs(metadataKey, "team-c"), gatewayMD),
+			wantErr: true,
+		},
+		{
+			name:    "collector call without token",
+			md:      metadata.Pairs(metadataKey, "team-b"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.resolve(metadata.NewIncomingContext(context.Background(), tt.md))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_resolveWithoutCollectorTokens(t *testing.T) {
+	resolver, err := NewResolver(nil)
+	assert.NoError(t, err)
+
+	got, err := resolver.resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Default, got)
+}
+
+func TestResolver_IncomingHeaderMatcher(t *testing.T) {
+	resolver, err := NewResolver(nil)
+	assert.NoError(t, err)
+
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(resolver.GatewayMetadata),
+		runtime.WithIncomingHeaderMatcher(resolver.IncomingHeaderMatcher),
+	)
+
+	request, err := http.NewRequest(http.MethodPost, "/", nil)
+	assert.NoError(t, err)
+	request.Header.Set("Grpc-Metadata-X-Organization", "team-c")
+	request.Header.Set("Grpc-Metadata-X-Gateway-Secret", "random")
+	request.Header.Set("Grpc-Metadata-X-Request-Id", "id")
+	request = request.WithContext(NewContext(request.Context(), "team-b"))
+
+	ctx, err := runtime.AnnotateIncomingContext(request.Context(), mux, request, "/borealis.v1beta1.Activities/GetProfile")
+	assert.NoError(t, err)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	assert.Equal(t, []string{"id"}, md.Get("x-request-id"))
+
+	got, err := resolver.resolve(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "team-b", got)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+
+	grpc_gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// mimeEventStream is the Accept header of the clients reading a streaming method as Server-Sent Events
+const mimeEventStream = "text/event-stream"
+
+// eventStreamMarshaler writes every message of a stream as a Server-Sent Event, the messages must fit on one line
+type eventStreamMarshaler struct {
+	*grpc_gateway.JSONPb
+}
+
+func (m eventStreamMarshaler) ContentType(_ interface{}) string {
+	return mimeEventStream
+}
+
+func (m eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("data: "), bytes.TrimSpace(data)...), nil
+}
+
+// Delimiter ends the event after every message
+func (m eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
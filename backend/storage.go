@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-migrate/migrate"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" // register postgres database/sql driver
+	"github.com/sirupsen/logrus"
+	_ "modernc.org/sqlite" // register sqlite database/sql driver
+)
+
+const (
+	storageClickHouse = "clickhouse"
+	storageSQLite     = "sqlite"
+	storagePostgres   = "postgres"
+)
+
+func clickhouseDSN() string {
+	return fmt.Sprintf("clickhouse://%v:%v/backend", *clickhouseHost, *clickhousePort)
+}
+
+// openStorage connects to the database selected with the --storage flag
+func openStorage(log *logrus.Entry) *sqlx.DB {
+	switch *storage {
+	case storageSQLite:
+		return NewSQLDB(storageSQLite, *sqlitePath, maxIdleConns, maxOpenConns, log, *migrationsFolder, *skipMigrations)
+	case storagePostgres:
+		return NewSQLDB(storagePostgres, *postgresDSN, maxIdleConns, maxOpenConns, log, *migrationsFolder, *skipMigrations)
+	default:
+		return NewDB(clickhouseDSN(), maxIdleConns, maxOpenConns, log, *migrationsFolder, *skipMigrations)
+	}
+}
+
+// NewSQLDB return a SQLite or PostgreSQL db, only the saved plans are stored, thus it can be used only in core mode.
+func NewSQLDB(driver, dsn string, maxIdleConns, maxOpenConns int, log *logrus.Entry, migrationFolder string, skipMigrations bool) *sqlx.DB {
+	log.Infof("connecting to %v database", driver)
+	db, err := sqlx.Connect(driver, dsn)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	db.Mapper = newMapper()
+
+	if driver == storageSQLite {
+		// SQLite allows only one writer at the time
+		maxIdleConns, maxOpenConns = 1, 1
+	}
+	db.SetMaxIdleConns(maxIdleConns)
+	db.SetMaxOpenConns(maxOpenConns)
+
+	if skipMigrations {
+		log.Infof("Migrations skipped.")
+		return db
+	}
+
+	if err := runSQLMigrations(db, filepath.Join(migrationFolder, driver)); err != nil {
+		log.Fatal("Migrations: ", err)
+	}
+	log.Infof("Migrations applied.")
+	return db
+}
+
+// sqlMigrationFiles returns the *.up.sql files of the folder in name order
+func sqlMigrationFiles(migrationFolder string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(migrationFolder, "*.up.sql"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no migrations found in %v", migrationFolder)
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func createSchemaMigrations(db *sqlx.DB) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version TEXT PRIMARY KEY)`); err != nil {
+		return fmt.Errorf("could not create schema_migrations: %v", err)
+	}
+
+	return nil
+}
+
+// execSQLMigration runs the statements of a migration file, they are separated by semicolons
+func execSQLMigration(db *sqlx.DB, file string) error {
+	migration, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("could not read migration %v: %v", file, err)
+	}
+
+	for _, statement := range strings.Split(string(migration), ";") {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+		if _, err := db.Exec(statement); err != nil {
+			return fmt.Errorf("could not apply migration %v: %v", file, err)
+		}
+	}
+
+	return nil
+}
+
+// runSQLMigrations applies the *.up.sql files in name order, the applied files are recorded
+// in schema_migrations so that each one runs only once.
+func runSQLMigrations(db *sqlx.DB, migrationFolder string) error {
+	files, err := sqlMigrationFiles(migrationFolder)
+	if err != nil {
+		return err
+	}
+
+	if err := createSchemaMigrations(db); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		version := filepath.Base(file)
+
+		var applied int
+		if err := db.Get(&applied, db.Rebind(`SELECT count(*) FROM schema_migrations WHERE version = ?`), version); err != nil {
+			return fmt.Errorf("could not check migration %v: %v", version, err)
+		}
+		if applied > 0 {
+			continue
+		}
+
+		if err := execSQLMigration(db, file); err != nil {
+			return err
+		}
+
+		if _, err := db.Exec(db.Rebind(`INSERT INTO schema_migrations (version) VALUES (?)`), version); err != nil {
+			return fmt.Errorf("could not record migration %v: %v", version, err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackSQLMigrations runs the *.down.sql files of the latest steps applied migrations, the newest first,
+// and removes them from schema_migrations. It returns migrate.ErrNoChange when no migration is applied.
+func rollbackSQLMigrations(db *sqlx.DB, migrationFolder string, steps int) error {
+	if err := createSchemaMigrations(db); err != nil {
+		return err
+	}
+
+	var versions []string
+	if err := db.Select(&versions, db.Rebind(`SELECT version FROM schema_migrations ORDER BY version DESC LIMIT ?`), steps); err != nil {
+		return fmt.Errorf("could not get applied migrations: %v", err)
+	}
+	if len(versions) == 0 {
+		return migrate.ErrNoChange
+	}
+
+	for _, version := range versions {
+		file := filepath.Join(migrationFolder, strings.TrimSuffix(version, ".up.sql")+".down.sql")
+		if err := execSQLMigration(db, file); err != nil {
+			return err
+		}
+
+		if _, err := db.Exec(db.Rebind(`DELETE FROM schema_migrations WHERE version = ?`), version); err != nil {
+			return fmt.Errorf("could not remove migration %v: %v", version, err)
+		}
+	}
+
+	return nil
+}
+
+// sqlSchemaVersion returns the latest applied migration, empty if no migration was applied
+func sqlSchemaVersion(db *sqlx.DB) (string, error) {
+	if err := createSchemaMigrations(db); err != nil {
+		return "", err
+	}
+
+	var version string
+	if err := db.Get(&version, `SELECT COALESCE(MAX(version), '') FROM schema_migrations`); err != nil {
+		return "", fmt.Errorf("could not get schema version: %v", err)
+	}
+
+	return version, nil
+}
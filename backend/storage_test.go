@@ -0,0 +1,58 @@
+package main
+
+import (
+	"github.com/golang-migrate/migrate"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLMigrations_SQLite(t *testing.T) {
+	db, err := sqlx.Connect(storageSQLite, filepath.Join(t.TempDir(), "backend.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	migrationFolder := filepath.Join("migrations", storageSQLite)
+
+	tables := func() []string {
+		var names []string
+		assert.NoError(t, db.Select(&names, `SELECT name FROM sqlite_master WHERE type = 'table' AND name = 'plans'`))
+		return names
+	}
+	hasOrganization := func() bool {
+		var count int
+		assert.NoError(t, db.Get(&count, `SELECT count(*) FROM pragma_table_info('plans') WHERE name = 'organization'`))
+		return count > 0
+	}
+
+	// up
+	assert.NoError(t, runSQLMigrations(db, migrationFolder))
+	assert.Equal(t, []string{"plans"}, tables())
+	assert.True(t, hasOrganization())
+	version, err := sqlSchemaVersion(db)
+	assert.NoError(t, err)
+	assert.Equal(t, "02_organizations.up.sql", version)
+
+	// down one step
+	assert.NoError(t, rollbackSQLMigrations(db, migrationFolder, 1))
+	assert.False(t, hasOrganization())
+	version, err = sqlSchemaVersion(db)
+	assert.NoError(t, err)
+	assert.Equal(t, "01_plans.up.sql", version)
+
+	// down to the empty schema
+	assert.NoError(t, rollbackSQLMigrations(db, migrationFolder, 5))
+	assert.Empty(t, tables())
+	version, err = sqlSchemaVersion(db)
+	assert.NoError(t, err)
+	assert.Equal(t, "", version)
+	assert.Equal(t, migrate.ErrNoChange, rollbackSQLMigrations(db, migrationFolder, 1))
+
+	// up again
+	assert.NoError(t, runSQLMigrations(db, migrationFolder))
+	assert.Equal(t, []string{"plans"}, tables())
+	assert.True(t, hasOrganization())
+	version, err = sqlSchemaVersion(db)
+	assert.NoError(t, err)
+	assert.Equal(t, "02_organizations.up.sql", version)
+}
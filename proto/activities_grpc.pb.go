@@ -26,6 +26,28 @@ type ActivitiesClient interface {
 	GetTopQueries(ctx context.Context, in *GetTopQueriesRequest, opts ...grpc.CallOption) (*GetTopQueriesResponse, error)
 	GetTopQueriesByFingerprint(ctx context.Context, in *GetTopQueriesRequest, opts ...grpc.CallOption) (*GetTopQueriesByFingerprintResponse, error)
 	GetQueryDetails(ctx context.Context, in *GetQueryDetailsRequest, opts ...grpc.CallOption) (*GetQueryDetailsResponse, error)
+	// GetSessionTimeline returns the history of a single backend: its state and wait event intervals and its queries.
+	GetSessionTimeline(ctx context.Context, in *GetSessionTimelineRequest, opts ...grpc.CallOption) (*GetSessionTimelineResponse, error)
+	// CompareProfiles compares the load of a period with the load of a baseline window.
+	CompareProfiles(ctx context.Context, in *CompareProfilesRequest, opts ...grpc.CallOption) (*CompareProfilesResponse, error)
+	// GetTopClients ranks the clients (client host, application and user) by database load.
+	GetTopClients(ctx context.Context, in *GetTopClientsRequest, opts ...grpc.CallOption) (*GetTopClientsResponse, error)
+	// GetLongTransactions lists the transactions older or idle in transaction for longer than the thresholds.
+	GetLongTransactions(ctx context.Context, in *GetLongTransactionsRequest, opts ...grpc.CallOption) (*GetLongTransactionsResponse, error)
+	// GetMaintenanceLoad splits the load by backend type and attributes the autovacuum load to the tables.
+	GetMaintenanceLoad(ctx context.Context, in *GetMaintenanceLoadRequest, opts ...grpc.CallOption) (*GetMaintenanceLoadResponse, error)
+	// GetLockTree returns the lock blocking chains of the range, the longest first.
+	GetLockTree(ctx context.Context, in *GetLockTreeRequest, opts ...grpc.CallOption) (*GetLockTreeResponse, error)
+	// GetQueryLatencyDistribution returns the histogram and the heatmap over time of the latencies of a fingerprint,
+	// built from the durations of its executions seen by the samples.
+	GetQueryLatencyDistribution(ctx context.Context, in *GetQueryLatencyDistributionRequest, opts ...grpc.CallOption) (*GetQueryLatencyDistributionResponse, error)
+	// StreamActivity pushes the activity samples of a cluster as the collectors send them, for a live view during incidents.
+	// Over HTTP the batches are streamed as newline delimited JSON, or as Server-Sent Events with Accept: text/event-stream.
+	// The GET binding is the one of EventSource, its filters are read from the filter query parameters.
+	StreamActivity(ctx context.Context, in *StreamActivityRequest, opts ...grpc.CallOption) (Activities_StreamActivityClient, error)
+	// GetIngestionStats returns the state of the queue of the activity samples received by the backend, and the counters
+	// of the samples of the organization of the caller which were not stored since the backend started.
+	GetIngestionStats(ctx context.Context, in *GetIngestionStatsRequest, opts ...grpc.CallOption) (*GetIngestionStatsResponse, error)
 }
 
 type activitiesClient struct {
@@ -72,6 +94,110 @@ func (c *activitiesClient) GetQueryDetails(ctx context.Context, in *GetQueryDeta
 	return out, nil
 }
 
+func (c *activitiesClient) GetSessionTimeline(ctx context.Context, in *GetSessionTimelineRequest, opts ...grpc.CallOption) (*GetSessionTimelineResponse, error) {
+	out := new(GetSessionTimelineResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetSessionTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) CompareProfiles(ctx context.Context, in *CompareProfilesRequest, opts ...grpc.CallOption) (*CompareProfilesResponse, error) {
+	out := new(CompareProfilesResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/CompareProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) GetTopClients(ctx context.Context, in *GetTopClientsRequest, opts ...grpc.CallOption) (*GetTopClientsResponse, error) {
+	out := new(GetTopClientsResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetTopClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) GetLongTransactions(ctx context.Context, in *GetLongTransactionsRequest, opts ...grpc.CallOption) (*GetLongTransactionsResponse, error) {
+	out := new(GetLongTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetLongTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) GetMaintenanceLoad(ctx context.Context, in *GetMaintenanceLoadRequest, opts ...grpc.CallOption) (*GetMaintenanceLoadResponse, error) {
+	out := new(GetMaintenanceLoadResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetMaintenanceLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) GetLockTree(ctx context.Context, in *GetLockTreeRequest, opts ...grpc.CallOption) (*GetLockTreeResponse, error) {
+	out := new(GetLockTreeResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetLockTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) GetQueryLatencyDistribution(ctx context.Context, in *GetQueryLatencyDistributionRequest, opts ...grpc.CallOption) (*GetQueryLatencyDistributionResponse, error) {
+	out := new(GetQueryLatencyDistributionResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetQueryLatencyDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activitiesClient) StreamActivity(ctx context.Context, in *StreamActivityRequest, opts ...grpc.CallOption) (Activities_StreamActivityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Activities_ServiceDesc.Streams[0], "/borealis.v1beta1.Activities/StreamActivity", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &activitiesStreamActivityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Activities_StreamActivityClient interface {
+	Recv() (*StreamActivityResponse, error)
+	grpc.ClientStream
+}
+
+type activitiesStreamActivityClient struct {
+	grpc.ClientStream
+}
+
+func (x *activitiesStreamActivityClient) Recv() (*StreamActivityResponse, error) {
+	m := new(StreamActivityResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *activitiesClient) GetIngestionStats(ctx context.Context, in *GetIngestionStatsRequest, opts ...grpc.CallOption) (*GetIngestionStatsResponse, error) {
+	out := new(GetIngestionStatsResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Activities/GetIngestionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActivitiesServer is the server API for Activities service.
 // All implementations must embed UnimplementedActivitiesServer
 // for forward compatibility
@@ -80,6 +206,28 @@ type ActivitiesServer interface {
 	GetTopQueries(context.Context, *GetTopQueriesRequest) (*GetTopQueriesResponse, error)
 	GetTopQueriesByFingerprint(context.Context, *GetTopQueriesRequest) (*GetTopQueriesByFingerprintResponse, error)
 	GetQueryDetails(context.Context, *GetQueryDetailsRequest) (*GetQueryDetailsResponse, error)
+	// GetSessionTimeline returns the history of a single backend: its state and wait event intervals and its queries.
+	GetSessionTimeline(context.Context, *GetSessionTimelineRequest) (*GetSessionTimelineResponse, error)
+	// CompareProfiles compares the load of a period with the load of a baseline window.
+	CompareProfiles(context.Context, *CompareProfilesRequest) (*CompareProfilesResponse, error)
+	// GetTopClients ranks the clients (client host, application and user) by database load.
+	GetTopClients(context.Context, *GetTopClientsRequest) (*GetTopClientsResponse, error)
+	// GetLongTransactions lists the transactions older or idle in transaction for longer than the thresholds.
+	GetLongTransactions(context.Context, *GetLongTransactionsRequest) (*GetLongTransactionsResponse, error)
+	// GetMaintenanceLoad splits the load by backend type and attributes the autovacuum load to the tables.
+	GetMaintenanceLoad(context.Context, *GetMaintenanceLoadRequest) (*GetMaintenanceLoadResponse, error)
+	// GetLockTree returns the lock blocking chains of the range, the longest first.
+	GetLockTree(context.Context, *GetLockTreeRequest) (*GetLockTreeResponse, error)
+	// GetQueryLatencyDistribution returns the histogram and the heatmap over time of the latencies of a fingerprint,
+	// built from the durations of its executions seen by the samples.
+	GetQueryLatencyDistribution(context.Context, *GetQueryLatencyDistributionRequest) (*GetQueryLatencyDistributionResponse, error)
+	// StreamActivity pushes the activity samples of a cluster as the collectors send them, for a live view during incidents.
+	// Over HTTP the batches are streamed as newline delimited JSON, or as Server-Sent Events with Accept: text/event-stream.
+	// The GET binding is the one of EventSource, its filters are read from the filter query parameters.
+	StreamActivity(*StreamActivityRequest, Activities_StreamActivityServer) error
+	// GetIngestionStats returns the state of the queue of the activity samples received by the backend, and the counters
+	// of the samples of the organization of the caller which were not stored since the backend started.
+	GetIngestionStats(context.Context, *GetIngestionStatsRequest) (*GetIngestionStatsResponse, error)
 	mustEmbedUnimplementedActivitiesServer()
 }
 
@@ -99,6 +247,33 @@ func (UnimplementedActivitiesServer) GetTopQueriesByFingerprint(context.Context,
 func (UnimplementedActivitiesServer) GetQueryDetails(context.Context, *GetQueryDetailsRequest) (*GetQueryDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQueryDetails not implemented")
 }
+func (UnimplementedActivitiesServer) GetSessionTimeline(context.Context, *GetSessionTimelineRequest) (*GetSessionTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionTimeline not implemented")
+}
+func (UnimplementedActivitiesServer) CompareProfiles(context.Context, *CompareProfilesRequest) (*CompareProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareProfiles not implemented")
+}
+func (UnimplementedActivitiesServer) GetTopClients(context.Context, *GetTopClientsRequest) (*GetTopClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopClients not implemented")
+}
+func (UnimplementedActivitiesServer) GetLongTransactions(context.Context, *GetLongTransactionsRequest) (*GetLongTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLongTransactions not implemented")
+}
+func (UnimplementedActivitiesServer) GetMaintenanceLoad(context.Context, *GetMaintenanceLoadRequest) (*GetMaintenanceLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceLoad not implemented")
+}
+func (UnimplementedActivitiesServer) GetLockTree(context.Context, *GetLockTreeRequest) (*GetLockTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockTree not implemented")
+}
+func (UnimplementedActivitiesServer) GetQueryLatencyDistribution(context.Context, *GetQueryLatencyDistributionRequest) (*GetQueryLatencyDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryLatencyDistribution not implemented")
+}
+func (UnimplementedActivitiesServer) StreamActivity(*StreamActivityRequest, Activities_StreamActivityServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamActivity not implemented")
+}
+func (UnimplementedActivitiesServer) GetIngestionStats(context.Context, *GetIngestionStatsRequest) (*GetIngestionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngestionStats not implemented")
+}
 func (UnimplementedActivitiesServer) mustEmbedUnimplementedActivitiesServer() {}
 
 // UnsafeActivitiesServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +359,171 @@ func _Activities_GetQueryDetails_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Activities_GetSessionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetSessionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetSessionTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetSessionTimeline(ctx, req.(*GetSessionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_CompareProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).CompareProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/CompareProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).CompareProfiles(ctx, req.(*CompareProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_GetTopClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetTopClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetTopClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetTopClients(ctx, req.(*GetTopClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_GetLongTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLongTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetLongTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetLongTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetLongTransactions(ctx, req.(*GetLongTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_GetMaintenanceLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetMaintenanceLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetMaintenanceLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetMaintenanceLoad(ctx, req.(*GetMaintenanceLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_GetLockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLockTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetLockTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetLockTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetLockTree(ctx, req.(*GetLockTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_GetQueryLatencyDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryLatencyDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetQueryLatencyDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetQueryLatencyDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetQueryLatencyDistribution(ctx, req.(*GetQueryLatencyDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Activities_StreamActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamActivityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ActivitiesServer).StreamActivity(m, &activitiesStreamActivityServer{stream})
+}
+
+type Activities_StreamActivityServer interface {
+	Send(*StreamActivityResponse) error
+	grpc.ServerStream
+}
+
+type activitiesStreamActivityServer struct {
+	grpc.ServerStream
+}
+
+func (x *activitiesStreamActivityServer) Send(m *StreamActivityResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Activities_GetIngestionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngestionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivitiesServer).GetIngestionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Activities/GetIngestionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivitiesServer).GetIngestionStats(ctx, req.(*GetIngestionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Activities_ServiceDesc is the grpc.ServiceDesc for Activities service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,7 +547,45 @@ var Activities_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQueryDetails",
 			Handler:    _Activities_GetQueryDetails_Handler,
 		},
+		{
+			MethodName: "GetSessionTimeline",
+			Handler:    _Activities_GetSessionTimeline_Handler,
+		},
+		{
+			MethodName: "CompareProfiles",
+			Handler:    _Activities_CompareProfiles_Handler,
+		},
+		{
+			MethodName: "GetTopClients",
+			Handler:    _Activities_GetTopClients_Handler,
+		},
+		{
+			MethodName: "GetLongTransactions",
+			Handler:    _Activities_GetLongTransactions_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceLoad",
+			Handler:    _Activities_GetMaintenanceLoad_Handler,
+		},
+		{
+			MethodName: "GetLockTree",
+			Handler:    _Activities_GetLockTree_Handler,
+		},
+		{
+			MethodName: "GetQueryLatencyDistribution",
+			Handler:    _Activities_GetQueryLatencyDistribution_Handler,
+		},
+		{
+			MethodName: "GetIngestionStats",
+			Handler:    _Activities_GetIngestionStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamActivity",
+			Handler:       _Activities_StreamActivity_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "activities.proto",
 }
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.6.1
+// source: admin.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
+	// ExportData streams the stored rows as NDJSON, one {"table": ..., "row": ...} object per line.
+	// Only the rows of the organization of the caller are exported.
+	ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (Admin_ExportDataClient, error)
+	// ImportData inserts back the rows of an export, the dump can be sent in several chunks.
+	// The rows are assigned to the organization of the caller.
+	ImportData(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportDataClient, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Admin/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (Admin_ExportDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], "/borealis.v1beta1.Admin/ExportData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminExportDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_ExportDataClient interface {
+	Recv() (*ExportDataResponse, error)
+	grpc.ClientStream
+}
+
+type adminExportDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminExportDataClient) Recv() (*ExportDataResponse, error) {
+	m := new(ExportDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) ImportData(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[1], "/borealis.v1beta1.Admin/ImportData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminImportDataClient{stream}
+	return x, nil
+}
+
+type Admin_ImportDataClient interface {
+	Send(*ImportDataRequest) error
+	CloseAndRecv() (*ImportDataResponse, error)
+	grpc.ClientStream
+}
+
+type adminImportDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminImportDataClient) Send(m *ImportDataRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminImportDataClient) CloseAndRecv() (*ImportDataResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
+	// ExportData streams the stored rows as NDJSON, one {"table": ..., "row": ...} object per line.
+	// Only the rows of the organization of the caller are exported.
+	ExportData(*ExportDataRequest, Admin_ExportDataServer) error
+	// ImportData inserts back the rows of an export, the dump can be sent in several chunks.
+	// The rows are assigned to the organization of the caller.
+	ImportData(Admin_ImportDataServer) error
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedAdminServer) ExportData(*ExportDataRequest, Admin_ExportDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedAdminServer) ImportData(Admin_ImportDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Admin/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).ExportData(m, &adminExportDataServer{stream})
+}
+
+type Admin_ExportDataServer interface {
+	Send(*ExportDataResponse) error
+	grpc.ServerStream
+}
+
+type adminExportDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminExportDataServer) Send(m *ExportDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_ImportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServer).ImportData(&adminImportDataServer{stream})
+}
+
+type Admin_ImportDataServer interface {
+	SendAndClose(*ImportDataResponse) error
+	Recv() (*ImportDataRequest, error)
+	grpc.ServerStream
+}
+
+type adminImportDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminImportDataServer) SendAndClose(m *ImportDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminImportDataServer) Recv() (*ImportDataRequest, error) {
+	m := new(ImportDataRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "borealis.v1beta1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _Admin_GetStorageUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportData",
+			Handler:       _Admin_ExportData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportData",
+			Handler:       _Admin_ImportData_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "admin.proto",
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.6.1
+// source: anomalies.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AnomaliesClient is the client API for Anomalies service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnomaliesClient interface {
+	// GetAnomalies returns the anomalous load windows detected in the range, ordered by start.
+	GetAnomalies(ctx context.Context, in *GetAnomaliesRequest, opts ...grpc.CallOption) (*GetAnomaliesResponse, error)
+}
+
+type anomaliesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnomaliesClient(cc grpc.ClientConnInterface) AnomaliesClient {
+	return &anomaliesClient{cc}
+}
+
+func (c *anomaliesClient) GetAnomalies(ctx context.Context, in *GetAnomaliesRequest, opts ...grpc.CallOption) (*GetAnomaliesResponse, error) {
+	out := new(GetAnomaliesResponse)
+	err := c.cc.Invoke(ctx, "/borealis.v1beta1.Anomalies/GetAnomalies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnomaliesServer is the server API for Anomalies service.
+// All implementations must embed UnimplementedAnomaliesServer
+// for forward compatibility
+type AnomaliesServer interface {
+	// GetAnomalies returns the anomalous load windows detected in the range, ordered by start.
+	GetAnomalies(context.Context, *GetAnomaliesRequest) (*GetAnomaliesResponse, error)
+	mustEmbedUnimplementedAnomaliesServer()
+}
+
+// UnimplementedAnomaliesServer must be embedded to have forward compatible implementations.
+type UnimplementedAnomaliesServer struct {
+}
+
+func (UnimplementedAnomaliesServer) GetAnomalies(context.Context, *GetAnomaliesRequest) (*GetAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedAnomaliesServer) mustEmbedUnimplementedAnomaliesServer() {}
+
+// UnsafeAnomaliesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnomaliesServer will
+// result in compilation errors.
+type UnsafeAnomaliesServer interface {
+	mustEmbedUnimplementedAnomaliesServer()
+}
+
+func RegisterAnomaliesServer(s grpc.ServiceRegistrar, srv AnomaliesServer) {
+	s.RegisterService(&Anomalies_ServiceDesc, srv)
+}
+
+func _Anomalies_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnomaliesServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/borealis.v1beta1.Anomalies/GetAnomalies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnomaliesServer).GetAnomalies(ctx, req.(*GetAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Anomalies_ServiceDesc is the grpc.ServiceDesc for Anomalies service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Anomalies_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "borealis.v1beta1.Anomalies",
+	HandlerType: (*AnomaliesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _Anomalies_GetAnomalies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anomalies.proto",
+}